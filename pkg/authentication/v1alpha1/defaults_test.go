@@ -0,0 +1,71 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected runtime.Object
+	}{
+		{
+			name: "policy",
+			obj:  &Policy{Spec: PolicySpec{Peers: []PeerAuthenticationMethod{{Mtls: &MutualTLS{}}, {}}}},
+			expected: &Policy{Spec: PolicySpec{
+				Peers:            []PeerAuthenticationMethod{{Mtls: &MutualTLS{Mode: ModeStrict}}, {}},
+				PrincipalBinding: PrincipalBindingUserPeer,
+			}},
+		},
+		{
+			name: "policy with settings",
+			obj: &Policy{Spec: PolicySpec{
+				Peers:            []PeerAuthenticationMethod{{Mtls: &MutualTLS{Mode: ModePermissive}}},
+				PrincipalBinding: PrincipalBindingUserOrigin,
+			}},
+			expected: &Policy{Spec: PolicySpec{
+				Peers:            []PeerAuthenticationMethod{{Mtls: &MutualTLS{Mode: ModePermissive}}},
+				PrincipalBinding: PrincipalBindingUserOrigin,
+			}},
+		},
+		{
+			name: "mesh policy",
+			obj:  &MeshPolicy{Spec: PolicySpec{Peers: []PeerAuthenticationMethod{{Mtls: &MutualTLS{}}}}},
+			expected: &MeshPolicy{Spec: PolicySpec{
+				Peers:            []PeerAuthenticationMethod{{Mtls: &MutualTLS{Mode: ModeStrict}}},
+				PrincipalBinding: PrincipalBindingUserPeer,
+			}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme.Default(tt.obj)
+			if !reflect.DeepEqual(tt.obj, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, tt.obj)
+			}
+		})
+	}
+}
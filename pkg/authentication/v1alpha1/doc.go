@@ -16,5 +16,7 @@
 // backward compatibility by support multiple concurrent versions
 // of the same resource
 // +k8s:deepcopy-gen=package
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
 // +groupName=authentication.istio.io
 package v1alpha1
@@ -19,7 +19,9 @@ import (
 )
 
 // +genclient
+// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;authentication-istio-io,scope=Cluster
 // MeshPolicy
 type MeshPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
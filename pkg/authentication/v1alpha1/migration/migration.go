@@ -0,0 +1,394 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration translates the deprecated authentication Policy and
+// MeshPolicy resources into their security v1beta1 replacements.
+package migration
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	commonv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// DefaultRootNamespace is the namespace mesh wide resources are created in
+// unless configured otherwise.
+const DefaultRootNamespace = "istio-system"
+
+// ServiceResolver returns the Kubernetes service a policy target refers to.
+type ServiceResolver func(namespace, name string) (*corev1.Service, error)
+
+// Warning describes a part of a policy which could not be translated, or
+// which behaves differently after the translation.
+type Warning struct {
+	Field   string
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// Result holds the resources replacing a policy.
+type Result struct {
+	PeerAuthentications    []*v1beta1.PeerAuthentication
+	RequestAuthentications []*v1beta1.RequestAuthentication
+	AuthorizationPolicies  []*v1beta1.AuthorizationPolicy
+	Warnings               []Warning
+}
+
+// Converter translates authentication policies.
+type Converter struct {
+	// RootNamespace is where the resources replacing a MeshPolicy are put.
+	// Defaults to DefaultRootNamespace when empty.
+	RootNamespace string
+	// Services resolves the services targeted by policies, which is required
+	// to translate targets into workload selectors and service ports into
+	// workload ports. Targets are skipped with a warning when it is nil.
+	Services ServiceResolver
+}
+
+// ConvertPolicy translates a namespaced Policy.
+func (c *Converter) ConvertPolicy(policy *v1alpha1.Policy) *Result {
+	result := &Result{}
+	c.convertSpec(result, policy.ObjectMeta, &policy.Spec)
+
+	return result
+}
+
+// ConvertMeshPolicy translates a MeshPolicy into resources of the root
+// namespace.
+func (c *Converter) ConvertMeshPolicy(policy *v1alpha1.MeshPolicy) *Result {
+	meta := policy.ObjectMeta
+	meta.Namespace = c.RootNamespace
+	if meta.Namespace == "" {
+		meta.Namespace = DefaultRootNamespace
+	}
+
+	result := &Result{}
+	spec := policy.Spec
+	if len(spec.Targets) > 0 {
+		result.Warnings = append(result.Warnings, Warning{field.NewPath("spec", "targets").String(), "targets of a MeshPolicy are ignored by Istio and are dropped"})
+		spec.Targets = nil
+	}
+	c.convertSpec(result, meta, &spec)
+
+	return result
+}
+
+// workload is the set of workloads an output resource applies to.
+type workload struct {
+	name     string
+	selector *selector.WorkloadSelector
+	// ports lists the workload ports a target selects, nil when the target
+	// has no port selectors.
+	ports []uint32
+}
+
+func (c *Converter) convertSpec(result *Result, meta metav1.ObjectMeta, spec *v1alpha1.PolicySpec) {
+	specPath := field.NewPath("spec")
+
+	if spec.PrincipalBinding == v1alpha1.PrincipalBindingUserOrigin {
+		result.Warnings = append(result.Warnings, Warning{specPath.Child("principalBinding").String(),
+			"USE_ORIGIN has no equivalent, the request principal has to be matched with requestPrincipals in authorization policies"})
+	}
+
+	workloads := []workload{{name: meta.Name}}
+	if len(spec.Targets) > 0 {
+		workloads = nil
+		for i := range spec.Targets {
+			if w, ok := c.resolveTarget(result, meta.Name, meta.Namespace, &spec.Targets[i], specPath.Child("targets").Index(i)); ok {
+				workloads = append(workloads, w)
+			}
+		}
+	}
+
+	mtls := convertPeers(result, spec, specPath)
+	jwtRules, triggerRules, hasAllPaths := convertOrigins(result, spec, specPath)
+
+	for _, w := range workloads {
+		objectMeta := metav1.ObjectMeta{
+			Name:        w.name,
+			Namespace:   meta.Namespace,
+			Labels:      meta.Labels,
+			Annotations: meta.Annotations,
+		}
+
+		peerAuthentication := &v1beta1.PeerAuthentication{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "PeerAuthentication"},
+			ObjectMeta: objectMeta,
+			Spec:       v1beta1.PeerAuthenticationSpec{Selector: w.selector},
+		}
+		if w.ports == nil {
+			peerAuthentication.Spec.Mtls = &v1beta1.PeerAuthenticationMTLS{Mode: mtls}
+		} else {
+			peerAuthentication.Spec.PortLevelMtls.Ports = map[uint32]*v1beta1.PeerAuthenticationMTLS{}
+			for _, port := range w.ports {
+				peerAuthentication.Spec.PortLevelMtls.Ports[port] = &v1beta1.PeerAuthenticationMTLS{Mode: mtls}
+			}
+		}
+		result.PeerAuthentications = append(result.PeerAuthentications, peerAuthentication)
+
+		if len(jwtRules) == 0 {
+			continue
+		}
+		result.RequestAuthentications = append(result.RequestAuthentications, &v1beta1.RequestAuthentication{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "RequestAuthentication"},
+			ObjectMeta: objectMeta,
+			Spec: v1beta1.RequestAuthenticationSpec{
+				Selector: w.selector,
+				JwtRules: copyJWTRules(jwtRules),
+			},
+		})
+
+		if spec.OriginIsOptional {
+			continue
+		}
+		authorizationMeta := objectMeta
+		authorizationMeta.Name = w.name + "-require-jwt"
+		result.AuthorizationPolicies = append(result.AuthorizationPolicies, &v1beta1.AuthorizationPolicy{
+			TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: "AuthorizationPolicy"},
+			ObjectMeta: authorizationMeta,
+			Spec: v1beta1.AuthorizationPolicySpec{
+				Selector: w.selector,
+				Action:   v1beta1.AuthorizationPolicyActionDeny,
+				Rules:    requireJWTRules(triggerRules, hasAllPaths, w.ports),
+			},
+		})
+	}
+}
+
+// resolveTarget looks up the service of the target and returns the workloads
+// behind it.
+func (c *Converter) resolveTarget(result *Result, policyName, namespace string, target *v1alpha1.TargetSelector, fldPath *field.Path) (workload, bool) {
+	if c.Services == nil {
+		result.Warnings = append(result.Warnings, Warning{fldPath.String(), fmt.Sprintf("service %q cannot be resolved without a service resolver, target is skipped", target.Name)})
+		return workload{}, false
+	}
+	service, err := c.Services(namespace, target.Name)
+	if err != nil || service == nil {
+		result.Warnings = append(result.Warnings, Warning{fldPath.String(), fmt.Sprintf("service %q cannot be resolved, target is skipped: %v", target.Name, err)})
+		return workload{}, false
+	}
+	if len(service.Spec.Selector) == 0 {
+		result.Warnings = append(result.Warnings, Warning{fldPath.String(), fmt.Sprintf("service %q has no selector, target is skipped", target.Name)})
+		return workload{}, false
+	}
+
+	w := workload{
+		name:     policyName + "-" + target.Name,
+		selector: &selector.WorkloadSelector{MatchLabels: service.Spec.Selector},
+	}
+	for i, port := range target.Ports {
+		if port == nil {
+			continue
+		}
+		portPath := fldPath.Child("ports").Index(i)
+		servicePort := findServicePort(service, port)
+		if servicePort == nil {
+			result.Warnings = append(result.Warnings, Warning{portPath.String(), fmt.Sprintf("port is not exposed by service %q, it is skipped", target.Name)})
+			continue
+		}
+		switch {
+		case servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal != 0:
+			w.ports = append(w.ports, uint32(servicePort.TargetPort.IntVal))
+		case servicePort.TargetPort.Type == intstr.Int:
+			w.ports = append(w.ports, uint32(servicePort.Port))
+		default:
+			result.Warnings = append(result.Warnings, Warning{portPath.String(), fmt.Sprintf("named target port %q cannot be translated to a workload port, it is skipped", servicePort.TargetPort.StrVal)})
+		}
+	}
+	if len(target.Ports) > 0 && len(w.ports) == 0 {
+		result.Warnings = append(result.Warnings, Warning{fldPath.Child("ports").String(), "none of the ports could be translated, target is skipped"})
+		return workload{}, false
+	}
+
+	return w, true
+}
+
+func findServicePort(service *corev1.Service, port *v1alpha1.PortSelector) *corev1.ServicePort {
+	for i := range service.Spec.Ports {
+		servicePort := &service.Spec.Ports[i]
+		if port.Number != nil && uint32(servicePort.Port) == *port.Number {
+			return servicePort
+		}
+		if port.Name != nil && servicePort.Name == *port.Name {
+			return servicePort
+		}
+	}
+
+	return nil
+}
+
+// convertPeers returns the mTLS mode which replaces the peer authentication
+// methods of the policy. Policies without mTLS peers accept plain text.
+func convertPeers(result *Result, spec *v1alpha1.PolicySpec, specPath *field.Path) v1beta1.MTLSMode {
+	mode := v1beta1.MTLSModeDisable
+	found := false
+	for i, peer := range spec.Peers {
+		peerPath := specPath.Child("peers").Index(i)
+		if peer.Jwt != nil {
+			result.Warnings = append(result.Warnings, Warning{peerPath.Child("jwt").String(), "peer JWT authentication is not supported, it is dropped"})
+		}
+		if peer.Mtls == nil || found {
+			continue
+		}
+		found = true
+		mode = v1beta1.MTLSModeStrict
+		if peer.Mtls.Mode == v1alpha1.ModePermissive {
+			mode = v1beta1.MTLSModePermissive
+		}
+		if peer.Mtls.AllowTLS {
+			result.Warnings = append(result.Warnings, Warning{peerPath.Child("mtls", "allowTls").String(), "plain TLS connections are no longer accepted"})
+		}
+	}
+	if found && spec.PeerIsOptional {
+		mode = v1beta1.MTLSModePermissive
+	}
+
+	return mode
+}
+
+// convertOrigins returns the JWT rules replacing the origins of the policy,
+// along with the paths the origins are required on. hasAllPaths is set when
+// one of the origins applies to every path.
+func convertOrigins(result *Result, spec *v1alpha1.PolicySpec, specPath *field.Path) ([]*v1beta1.JWTRule, []*v1beta1.Operation, bool) {
+	var rules []*v1beta1.JWTRule
+	var operations []*v1beta1.Operation
+	hasAllPaths := false
+
+	for i, origin := range spec.Origins {
+		jwtPath := specPath.Child("origins").Index(i).Child("jwt")
+		if origin.Jwt == nil {
+			continue
+		}
+		jwt := origin.Jwt
+
+		rule := &v1beta1.JWTRule{
+			Issuer:     jwt.Issuer,
+			Audiences:  jwt.Audiences,
+			JwksURI:    jwt.JwksURI,
+			Jwks:       jwt.Jwks,
+			FromParams: jwt.JwtParams,
+		}
+		for _, header := range jwt.JwtHeaders {
+			rule.FromHeaders = append(rule.FromHeaders, &v1beta1.JWTHeader{Name: header})
+		}
+		rules = append(rules, rule)
+
+		if len(jwt.TriggerRules) == 0 {
+			hasAllPaths = true
+			continue
+		}
+		if spec.OriginIsOptional {
+			result.Warnings = append(result.Warnings, Warning{jwtPath.Child("triggerRules").String(), "trigger rules of an optional origin have no effect, they are dropped"})
+			continue
+		}
+		result.Warnings = append(result.Warnings, Warning{jwtPath.Child("triggerRules").String(),
+			"tokens are validated on every path, trigger rules only limit the paths requiring a token"})
+		for j, trigger := range jwt.TriggerRules {
+			if trigger == nil {
+				continue
+			}
+			triggerPath := jwtPath.Child("triggerRules").Index(j)
+			operation := &v1beta1.Operation{}
+			for k, included := range trigger.IncludedPaths {
+				if path, ok := convertPath(result, included, triggerPath.Child("includedPaths").Index(k)); ok {
+					operation.Paths = append(operation.Paths, path)
+				}
+			}
+			for k := range trigger.ExcludedPaths {
+				if path, ok := convertPath(result, &trigger.ExcludedPaths[k], triggerPath.Child("excludedPaths").Index(k)); ok {
+					operation.NotPaths = append(operation.NotPaths, path)
+				}
+			}
+			if len(operation.Paths) == 0 && len(operation.NotPaths) == 0 {
+				hasAllPaths = true
+				continue
+			}
+			operations = append(operations, operation)
+		}
+	}
+
+	return rules, operations, hasAllPaths
+}
+
+// convertPath translates a StringMatch of a trigger rule into the path syntax
+// of authorization policies, which lacks regular expressions.
+func convertPath(result *Result, match *commonv1alpha1.StringMatch, fldPath *field.Path) (string, bool) {
+	switch {
+	case match == nil:
+		return "", false
+	case match.Exact != "":
+		return match.Exact, true
+	case match.Prefix != "":
+		return match.Prefix + "*", true
+	case match.Suffix != "":
+		return "*" + match.Suffix, true
+	case match.Regex != "":
+		result.Warnings = append(result.Warnings, Warning{fldPath.Child("regex").String(), "regular expressions are not supported by authorization policies, the path is dropped"})
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+// requireJWTRules returns the rules of a DENY policy rejecting requests
+// without a request principal on the given operations, or on every request
+// if allPaths is set.
+func requireJWTRules(operations []*v1beta1.Operation, allPaths bool, ports []uint32) []*v1beta1.Rule {
+	from := []*v1beta1.RuleFrom{{Source: &v1beta1.Source{NotRequestPrincipals: []string{"*"}}}}
+
+	var portValues []string
+	for _, port := range ports {
+		portValues = append(portValues, fmt.Sprint(port))
+	}
+
+	if allPaths || len(operations) == 0 {
+		rule := &v1beta1.Rule{From: from}
+		if len(portValues) > 0 {
+			rule.To = []*v1beta1.RuleTo{{Operation: &v1beta1.Operation{Ports: portValues}}}
+		}
+		return []*v1beta1.Rule{rule}
+	}
+
+	rules := make([]*v1beta1.Rule, 0, len(operations))
+	for _, operation := range operations {
+		operation = operation.DeepCopy()
+		operation.Ports = portValues
+		rules = append(rules, &v1beta1.Rule{
+			From: from,
+			To:   []*v1beta1.RuleTo{{Operation: operation}},
+		})
+	}
+
+	return rules
+}
+
+func copyJWTRules(rules []*v1beta1.JWTRule) []*v1beta1.JWTRule {
+	copied := make([]*v1beta1.JWTRule, len(rules))
+	for i, rule := range rules {
+		copied[i] = rule.DeepCopy()
+	}
+
+	return copied
+}
@@ -0,0 +1,429 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/banzaicloud/istio-client-go/pkg/authentication/v1alpha1"
+	commonv1alpha1 "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func policy(spec v1alpha1.PolicySpec) *v1alpha1.Policy {
+	return &v1alpha1.Policy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "policy"},
+		Spec:       spec,
+	}
+}
+
+func warningFields(result *Result) []string {
+	fields := []string{}
+	for _, warning := range result.Warnings {
+		fields = append(fields, warning.Field)
+	}
+
+	return fields
+}
+
+// services resolves the reviews service of the default namespace, which
+// exposes its workloads with a numeric, a default and a named target port,
+// and the headless ratings service.
+func services(namespace, name string) (*corev1.Service, error) {
+	switch {
+	case namespace == "default" && name == "reviews":
+		return &corev1.Service{Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(9080)},
+				{Name: "grpc", Port: 90},
+				{Name: "web", Port: 8080, TargetPort: intstr.FromString("web")},
+			},
+		}}, nil
+	case namespace == "default" && name == "ratings":
+		return &corev1.Service{}, nil
+	default:
+		return nil, errors.New("not found")
+	}
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestConvertPeers(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     v1alpha1.PolicySpec
+		mode     v1beta1.MTLSMode
+		warnings []string
+	}{
+		{
+			name: "no peers",
+			mode: v1beta1.MTLSModeDisable,
+		},
+		{
+			name: "mutual TLS",
+			spec: v1alpha1.PolicySpec{Peers: []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{}}}},
+			mode: v1beta1.MTLSModeStrict,
+		},
+		{
+			name: "permissive mutual TLS",
+			spec: v1alpha1.PolicySpec{Peers: []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{Mode: v1alpha1.ModePermissive}}}},
+			mode: v1beta1.MTLSModePermissive,
+		},
+		{
+			name: "optional peer",
+			spec: v1alpha1.PolicySpec{
+				Peers:          []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{Mode: v1alpha1.ModeStrict}}},
+				PeerIsOptional: true,
+			},
+			mode: v1beta1.MTLSModePermissive,
+		},
+		{
+			name:     "plain TLS allowed",
+			spec:     v1alpha1.PolicySpec{Peers: []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{AllowTLS: true}}}},
+			mode:     v1beta1.MTLSModeStrict,
+			warnings: []string{"spec.peers[0].mtls.allowTls"},
+		},
+		{
+			name: "first mutual TLS peer",
+			spec: v1alpha1.PolicySpec{Peers: []v1alpha1.PeerAuthenticationMethod{
+				{Jwt: &v1alpha1.Jwt{Issuer: "https://issuer.example.com"}},
+				{Mtls: &v1alpha1.MutualTLS{Mode: v1alpha1.ModePermissive}},
+				{Mtls: &v1alpha1.MutualTLS{Mode: v1alpha1.ModeStrict, AllowTLS: true}},
+			}},
+			mode:     v1beta1.MTLSModePermissive,
+			warnings: []string{"spec.peers[0].jwt"},
+		},
+		{
+			name:     "origin principal binding",
+			spec:     v1alpha1.PolicySpec{PrincipalBinding: v1alpha1.PrincipalBindingUserOrigin},
+			mode:     v1beta1.MTLSModeDisable,
+			warnings: []string{"spec.principalBinding"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := (&Converter{}).ConvertPolicy(policy(tt.spec))
+			if len(result.PeerAuthentications) != 1 {
+				t.Fatalf("expected a PeerAuthentication, got %d", len(result.PeerAuthentications))
+			}
+			peerAuthentication := result.PeerAuthentications[0]
+			if peerAuthentication.Namespace != "default" || peerAuthentication.Name != "policy" || peerAuthentication.Spec.Selector != nil {
+				t.Errorf("expected the namespace wide default/policy, got %s/%s for %v", peerAuthentication.Namespace, peerAuthentication.Name, peerAuthentication.Spec.Selector)
+			}
+			if mode := peerAuthentication.Spec.Mtls.Mode; mode != tt.mode {
+				t.Errorf("expected %s, got %s", tt.mode, mode)
+			}
+			if tt.warnings == nil {
+				tt.warnings = []string{}
+			}
+			if fields := warningFields(result); !reflect.DeepEqual(fields, tt.warnings) {
+				t.Errorf("expected warnings on %v, got %v", tt.warnings, fields)
+			}
+		})
+	}
+}
+
+func TestConvertTargets(t *testing.T) {
+	strict := []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{}}}
+
+	tests := []struct {
+		name       string
+		noResolver bool
+		targets    []v1alpha1.TargetSelector
+		ports      map[string][]uint32
+		warnings   []string
+	}{
+		{
+			name:    "all ports",
+			targets: []v1alpha1.TargetSelector{{Name: "reviews"}},
+			ports:   map[string][]uint32{"policy-reviews": nil},
+		},
+		{
+			name:    "target port",
+			targets: []v1alpha1.TargetSelector{{Name: "reviews", Ports: []*v1alpha1.PortSelector{{Number: uint32Ptr(80)}}}},
+			ports:   map[string][]uint32{"policy-reviews": {9080}},
+		},
+		{
+			name:    "service port by name",
+			targets: []v1alpha1.TargetSelector{{Name: "reviews", Ports: []*v1alpha1.PortSelector{{Name: stringPtr("grpc")}, {Number: uint32Ptr(80)}}}},
+			ports:   map[string][]uint32{"policy-reviews": {90, 9080}},
+		},
+		{
+			name:     "named target port",
+			targets:  []v1alpha1.TargetSelector{{Name: "reviews", Ports: []*v1alpha1.PortSelector{{Name: stringPtr("web")}, {Number: uint32Ptr(80)}}}},
+			ports:    map[string][]uint32{"policy-reviews": {9080}},
+			warnings: []string{"spec.targets[0].ports[0]"},
+		},
+		{
+			name:     "no translatable ports",
+			targets:  []v1alpha1.TargetSelector{{Name: "reviews", Ports: []*v1alpha1.PortSelector{{Number: uint32Ptr(443)}}}},
+			ports:    map[string][]uint32{},
+			warnings: []string{"spec.targets[0].ports[0]", "spec.targets[0].ports"},
+		},
+		{
+			name:     "unresolved services",
+			targets:  []v1alpha1.TargetSelector{{Name: "details"}, {Name: "ratings"}, {Name: "reviews"}},
+			ports:    map[string][]uint32{"policy-reviews": nil},
+			warnings: []string{"spec.targets[0]", "spec.targets[1]"},
+		},
+		{
+			name:       "no resolver",
+			noResolver: true,
+			targets:    []v1alpha1.TargetSelector{{Name: "reviews"}},
+			ports:      map[string][]uint32{},
+			warnings:   []string{"spec.targets[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converter := &Converter{Services: services}
+			if tt.noResolver {
+				converter.Services = nil
+			}
+			result := converter.ConvertPolicy(policy(v1alpha1.PolicySpec{Targets: tt.targets, Peers: strict}))
+
+			ports := map[string][]uint32{}
+			for _, peerAuthentication := range result.PeerAuthentications {
+				if !reflect.DeepEqual(peerAuthentication.Spec.Selector.MatchLabels, map[string]string{"app": "reviews"}) {
+					t.Errorf("%s: expected the workloads of reviews to be selected, got %v", peerAuthentication.Name, peerAuthentication.Spec.Selector.MatchLabels)
+				}
+				if peerAuthentication.Spec.PortLevelMtls.IsZero() {
+					ports[peerAuthentication.Name] = nil
+					if mode := peerAuthentication.Spec.Mtls.Mode; mode != v1beta1.MTLSModeStrict {
+						t.Errorf("%s: expected %s, got %s", peerAuthentication.Name, v1beta1.MTLSModeStrict, mode)
+					}
+					continue
+				}
+				if peerAuthentication.Spec.Mtls != nil {
+					t.Errorf("%s: expected port level settings only, got %v", peerAuthentication.Name, peerAuthentication.Spec.Mtls)
+				}
+				for _, port := range portsOf(peerAuthentication) {
+					ports[peerAuthentication.Name] = append(ports[peerAuthentication.Name], port)
+					if mode := peerAuthentication.Spec.PortLevelMtls.Ports[port].Mode; mode != v1beta1.MTLSModeStrict {
+						t.Errorf("%s: expected %s on port %d, got %s", peerAuthentication.Name, v1beta1.MTLSModeStrict, port, mode)
+					}
+				}
+			}
+			if !reflect.DeepEqual(ports, tt.ports) {
+				t.Errorf("expected %v, got %v", tt.ports, ports)
+			}
+			if tt.warnings == nil {
+				tt.warnings = []string{}
+			}
+			if fields := warningFields(result); !reflect.DeepEqual(fields, tt.warnings) {
+				t.Errorf("expected warnings on %v, got %v", tt.warnings, fields)
+			}
+		})
+	}
+}
+
+// portsOf returns the ports of the port level settings in ascending order.
+func portsOf(peerAuthentication *v1beta1.PeerAuthentication) []uint32 {
+	var ports []uint32
+	for port := range peerAuthentication.Spec.PortLevelMtls.Ports {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+
+	return ports
+}
+
+func TestConvertOrigins(t *testing.T) {
+	jwt := func(triggers ...*v1alpha1.TriggerRule) v1alpha1.OriginAuthenticationMethod {
+		return v1alpha1.OriginAuthenticationMethod{Jwt: &v1alpha1.Jwt{
+			Issuer:       "https://issuer.example.com",
+			JwksURI:      "https://issuer.example.com/jwks",
+			JwtHeaders:   []string{"x-jwt-assertion"},
+			TriggerRules: triggers,
+		}}
+	}
+	requireToken := func(operation *v1beta1.Operation) *v1beta1.Rule {
+		rule := &v1beta1.Rule{From: []*v1beta1.RuleFrom{{Source: &v1beta1.Source{NotRequestPrincipals: []string{"*"}}}}}
+		if operation != nil {
+			rule.To = []*v1beta1.RuleTo{{Operation: operation}}
+		}
+		return rule
+	}
+
+	tests := []struct {
+		name     string
+		spec     v1alpha1.PolicySpec
+		rules    []*v1beta1.Rule
+		warnings []string
+	}{
+		{
+			name:  "required on every path",
+			spec:  v1alpha1.PolicySpec{Origins: []v1alpha1.OriginAuthenticationMethod{jwt()}},
+			rules: []*v1beta1.Rule{requireToken(nil)},
+		},
+		{
+			name: "optional",
+			spec: v1alpha1.PolicySpec{Origins: []v1alpha1.OriginAuthenticationMethod{jwt()}, OriginIsOptional: true},
+		},
+		{
+			name: "trigger rules",
+			spec: v1alpha1.PolicySpec{Origins: []v1alpha1.OriginAuthenticationMethod{jwt(&v1alpha1.TriggerRule{
+				IncludedPaths: []*commonv1alpha1.StringMatch{{Prefix: "/api/"}, {Suffix: ".json"}},
+				ExcludedPaths: []commonv1alpha1.StringMatch{{Exact: "/api/health"}},
+			})}},
+			rules:    []*v1beta1.Rule{requireToken(&v1beta1.Operation{Paths: []string{"/api/*", "*.json"}, NotPaths: []string{"/api/health"}})},
+			warnings: []string{"spec.origins[0].jwt.triggerRules"},
+		},
+		{
+			name: "trigger rule of a regular expression",
+			spec: v1alpha1.PolicySpec{Origins: []v1alpha1.OriginAuthenticationMethod{jwt(&v1alpha1.TriggerRule{
+				IncludedPaths: []*commonv1alpha1.StringMatch{{Regex: "/api/v[0-9]+/.*"}, {Exact: "/login"}},
+			})}},
+			rules:    []*v1beta1.Rule{requireToken(&v1beta1.Operation{Paths: []string{"/login"}})},
+			warnings: []string{"spec.origins[0].jwt.triggerRules", "spec.origins[0].jwt.triggerRules[0].includedPaths[0].regex"},
+		},
+		{
+			name: "trigger rules and an origin on every path",
+			spec: v1alpha1.PolicySpec{Origins: []v1alpha1.OriginAuthenticationMethod{
+				jwt(&v1alpha1.TriggerRule{IncludedPaths: []*commonv1alpha1.StringMatch{{Exact: "/login"}}}),
+				jwt(),
+			}},
+			rules:    []*v1beta1.Rule{requireToken(nil)},
+			warnings: []string{"spec.origins[0].jwt.triggerRules"},
+		},
+		{
+			name: "trigger rules of an optional origin",
+			spec: v1alpha1.PolicySpec{
+				Origins:          []v1alpha1.OriginAuthenticationMethod{jwt(&v1alpha1.TriggerRule{IncludedPaths: []*commonv1alpha1.StringMatch{{Exact: "/login"}}})},
+				OriginIsOptional: true,
+			},
+			warnings: []string{"spec.origins[0].jwt.triggerRules"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := (&Converter{}).ConvertPolicy(policy(tt.spec))
+
+			if len(result.RequestAuthentications) != 1 {
+				t.Fatalf("expected a RequestAuthentication, got %d", len(result.RequestAuthentications))
+			}
+			jwtRules := result.RequestAuthentications[0].Spec.JwtRules
+			if len(jwtRules) != len(tt.spec.Origins) {
+				t.Errorf("expected a JWT rule for each origin, got %d", len(jwtRules))
+			}
+			expected := &v1beta1.JWTRule{
+				Issuer:      "https://issuer.example.com",
+				JwksURI:     "https://issuer.example.com/jwks",
+				FromHeaders: []*v1beta1.JWTHeader{{Name: "x-jwt-assertion"}},
+			}
+			if !reflect.DeepEqual(jwtRules[0], expected) {
+				t.Errorf("expected %+v, got %+v", expected, jwtRules[0])
+			}
+
+			var rules []*v1beta1.Rule
+			for _, policy := range result.AuthorizationPolicies {
+				if policy.Name != "policy-require-jwt" || policy.Spec.Action != v1beta1.AuthorizationPolicyActionDeny {
+					t.Errorf("expected the DENY policy policy-require-jwt, got the %s policy %s", policy.Spec.Action, policy.Name)
+				}
+				rules = append(rules, policy.Spec.Rules...)
+			}
+			if !reflect.DeepEqual(rules, tt.rules) {
+				t.Errorf("expected %v, got %v", tt.rules, rules)
+			}
+			if tt.warnings == nil {
+				tt.warnings = []string{}
+			}
+			if fields := warningFields(result); !reflect.DeepEqual(fields, tt.warnings) {
+				t.Errorf("expected warnings on %v, got %v", tt.warnings, fields)
+			}
+		})
+	}
+}
+
+func TestConvertOriginsOfTargetPorts(t *testing.T) {
+	converter := &Converter{Services: services}
+	result := converter.ConvertPolicy(policy(v1alpha1.PolicySpec{
+		Targets: []v1alpha1.TargetSelector{{Name: "reviews", Ports: []*v1alpha1.PortSelector{{Number: uint32Ptr(80)}}}},
+		Origins: []v1alpha1.OriginAuthenticationMethod{{Jwt: &v1alpha1.Jwt{
+			Issuer:       "https://issuer.example.com",
+			TriggerRules: []*v1alpha1.TriggerRule{{IncludedPaths: []*commonv1alpha1.StringMatch{{Prefix: "/api/"}}}},
+		}}},
+	}))
+
+	if len(result.AuthorizationPolicies) != 1 {
+		t.Fatalf("expected an AuthorizationPolicy, got %d", len(result.AuthorizationPolicies))
+	}
+	policy := result.AuthorizationPolicies[0]
+	if policy.Name != "policy-reviews-require-jwt" {
+		t.Errorf("expected policy-reviews-require-jwt, got %s", policy.Name)
+	}
+	operation := policy.Spec.Rules[0].To[0].Operation
+	if !reflect.DeepEqual(operation, &v1beta1.Operation{Paths: []string{"/api/*"}, Ports: []string{"9080"}}) {
+		t.Errorf("expected the token to be required on /api/* of port 9080, got %+v", operation)
+	}
+}
+
+func TestConvertMeshPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		rootNamespace string
+		namespace     string
+	}{
+		{name: "default root namespace", namespace: DefaultRootNamespace},
+		{name: "custom root namespace", rootNamespace: "istio-config", namespace: "istio-config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meshPolicy := &v1alpha1.MeshPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: v1alpha1.PolicySpec{
+					Targets: []v1alpha1.TargetSelector{{Name: "reviews"}},
+					Peers:   []v1alpha1.PeerAuthenticationMethod{{Mtls: &v1alpha1.MutualTLS{}}},
+				},
+			}
+			result := (&Converter{RootNamespace: tt.rootNamespace, Services: services}).ConvertMeshPolicy(meshPolicy)
+
+			if len(result.PeerAuthentications) != 1 {
+				t.Fatalf("expected a PeerAuthentication, got %d", len(result.PeerAuthentications))
+			}
+			peerAuthentication := result.PeerAuthentications[0]
+			if peerAuthentication.Namespace != tt.namespace || peerAuthentication.Name != "default" {
+				t.Errorf("expected %s/default, got %s/%s", tt.namespace, peerAuthentication.Namespace, peerAuthentication.Name)
+			}
+			if peerAuthentication.Spec.Selector != nil {
+				t.Errorf("expected a mesh wide policy, got the selector %v", peerAuthentication.Spec.Selector)
+			}
+			if fields := warningFields(result); !reflect.DeepEqual(fields, []string{"spec.targets"}) {
+				t.Errorf("expected a warning on the targets, got %v", fields)
+			}
+			if meshPolicy.Namespace != "" || len(meshPolicy.Spec.Targets) != 1 {
+				t.Error("the MeshPolicy was modified")
+			}
+		})
+	}
+}
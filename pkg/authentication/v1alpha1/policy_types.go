@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;authentication-istio-io
 // VirtualService
 type Policy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -199,6 +200,7 @@ type PeerAuthenticationMethod struct {
 }
 
 // Defines the acceptable connection TLS mode.
+// +kubebuilder:validation:Enum=STRICT;PERMISSIVE
 type Mode string
 
 const (
@@ -378,6 +380,7 @@ type OriginAuthenticationMethod struct {
 }
 
 // Associates authentication with request principal.
+// +kubebuilder:validation:Enum=USE_PEER;USE_ORIGIN
 type PrincipalBinding string
 
 const (
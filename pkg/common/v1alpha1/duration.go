@@ -0,0 +1,104 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration wraps time.Duration. It is serialized the way protobuf durations
+// are in JSON, as a number of seconds with an "s" suffix, e.g. "1.5s". Any
+// value accepted by time.ParseDuration, e.g. "1m30s", is read as well.
+// +kubebuilder:validation:Type=string
+type Duration struct {
+	time.Duration
+}
+
+// NewDuration returns a pointer to a Duration of d.
+func NewDuration(d time.Duration) *Duration {
+	return &Duration{Duration: d}
+}
+
+// ParseDuration parses a protobuf duration string, or any other string
+// accepted by time.ParseDuration.
+func ParseDuration(s string) (Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Duration{}, err
+	}
+
+	return Duration{Duration: d}, nil
+}
+
+// String returns the protobuf representation of the duration, e.g. "1.5s".
+func (d Duration) String() string {
+	sign := ""
+	nanos := int64(d.Duration)
+	if nanos < 0 {
+		sign = "-"
+		nanos = -nanos
+	}
+	seconds := nanos / int64(time.Second)
+	nanos %= int64(time.Second)
+	if nanos == 0 {
+		return sign + strconv.FormatInt(seconds, 10) + "s"
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+
+	return sign + strconv.FormatInt(seconds, 10) + "." + fraction + "s"
+}
+
+// OpenAPISchemaType is used by the kube-openapi generator when constructing
+// the OpenAPI spec of this type.
+func (Duration) OpenAPISchemaType() []string { return []string{"string"} }
+
+// OpenAPISchemaFormat is used by the kube-openapi generator when constructing
+// the OpenAPI spec of this type.
+func (Duration) OpenAPISchemaFormat() string { return "" }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. null and the
+// empty string, which objects stored when durations were plain strings carry
+// for unset fields, decode to a zero duration.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		d.Duration = 0
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid duration %s: must be a string", b)
+	}
+	if s == "" {
+		d.Duration = 0
+		return nil
+	}
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
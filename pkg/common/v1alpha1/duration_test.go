@@ -0,0 +1,163 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+func TestDurationString(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{duration: 0, want: "0s"},
+		{duration: 1500 * time.Millisecond, want: "1.5s"},
+		{duration: 90 * time.Second, want: "90s"},
+		{duration: -250 * time.Millisecond, want: "-0.25s"},
+		{duration: time.Nanosecond, want: "0.000000001s"},
+	}
+
+	for _, tt := range tests {
+		if got := v1alpha1.NewDuration(tt.duration).String(); got != tt.want {
+			t.Errorf("%v: expected %s, got %s", tt.duration, tt.want, got)
+		}
+	}
+}
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{json: `"1.5s"`, want: 1500 * time.Millisecond},
+		{json: `"1m30s"`, want: 90 * time.Second},
+		{json: `"-2s"`, want: -2 * time.Second},
+		{json: `""`, want: 0},
+		{json: `null`, want: 0},
+		{json: `"1.5"`, wantErr: true},
+		{json: `"soon"`, wantErr: true},
+		{json: `15`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var d v1alpha1.Duration
+		err := json.Unmarshal([]byte(tt.json), &d)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", tt.json)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.json, err)
+			continue
+		}
+		if d.Duration != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.json, tt.want, d.Duration)
+		}
+	}
+}
+
+func TestDurationRoundTrip(t *testing.T) {
+	type object struct {
+		Value v1alpha1.Duration `json:"value"`
+	}
+	tests := []struct {
+		json string
+		want string
+	}{
+		{json: `{"value":""}`, want: `{"value":"0s"}`},
+		{json: `{"value":null}`, want: `{"value":"0s"}`},
+		{json: `{"value":"1.5s"}`, want: `{"value":"1.5s"}`},
+	}
+
+	for _, tt := range tests {
+		var decoded object
+		if err := json.Unmarshal([]byte(tt.json), &decoded); err != nil {
+			t.Errorf("%s: %v", tt.json, err)
+			continue
+		}
+		encoded, err := json.Marshal(decoded)
+		if err != nil {
+			t.Errorf("%s: %v", tt.json, err)
+			continue
+		}
+		if string(encoded) != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.json, tt.want, encoded)
+		}
+		var roundTripped object
+		if err := json.Unmarshal(encoded, &roundTripped); err != nil {
+			t.Errorf("%s: %v", encoded, err)
+			continue
+		}
+		if roundTripped != decoded {
+			t.Errorf("%s: expected %v, got %v", tt.json, decoded.Value, roundTripped.Value)
+		}
+	}
+}
+
+// TestDecodeStoredObjects decodes objects stored while duration fields were
+// strings, which carry "" for the unset ones.
+func TestDecodeStoredObjects(t *testing.T) {
+	vs := `{
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"kind": "VirtualService",
+		"metadata": {"name": "reviews"},
+		"spec": {
+			"hosts": ["reviews"],
+			"http": [{
+				"retries": {"attempts": 3, "perTryTimeout": ""},
+				"fault": {"delay": {"fixedDelay": ""}},
+				"timeout": "10s",
+				"route": [{"destination": {"host": "reviews"}}]
+			}]
+		}
+	}`
+	var virtualService v1alpha3.VirtualService
+	if err := json.Unmarshal([]byte(vs), &virtualService); err != nil {
+		t.Fatal(err)
+	}
+	route := virtualService.Spec.HTTP[0]
+	if route.Retries.PerTryTimeout.Duration != 0 || route.Fault.Delay.FixedDelay.Duration != 0 {
+		t.Errorf("expected zero durations, got %v and %v", route.Retries.PerTryTimeout, route.Fault.Delay.FixedDelay)
+	}
+	if route.Timeout.Duration != 10*time.Second {
+		t.Errorf("expected a 10s timeout, got %v", route.Timeout)
+	}
+
+	dr := `{
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"kind": "DestinationRule",
+		"metadata": {"name": "reviews"},
+		"spec": {
+			"host": "reviews",
+			"trafficPolicy": {"loadBalancer": {"consistentHash": {"httpCookie": {"name": "user", "ttl": ""}}}}
+		}
+	}`
+	var destinationRule v1alpha3.DestinationRule
+	if err := json.Unmarshal([]byte(dr), &destinationRule); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := destinationRule.Spec.TrafficPolicy.LoadBalancer.ConsistentHash.HTTPCookie.TTL; ttl.Duration != 0 {
+		t.Errorf("expected a zero ttl, got %v", ttl)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// ValidateStringMatch checks that exactly one of exact, prefix, suffix or
+// regex is set on the given StringMatch and that a regex compiles.
+func ValidateStringMatch(match *v1alpha1.StringMatch, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var set []string
+	if match.Exact != "" {
+		set = append(set, "exact")
+	}
+	if match.Prefix != "" {
+		set = append(set, "prefix")
+	}
+	if match.Suffix != "" {
+		set = append(set, "suffix")
+	}
+	if match.Regex != "" {
+		set = append(set, "regex")
+		if _, err := regexp.Compile(match.Regex); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("regex"), match.Regex, err.Error()))
+		}
+	}
+
+	switch len(set) {
+	case 0:
+		allErrs = append(allErrs, field.Required(fldPath, "one of exact, prefix, suffix or regex must be set"))
+	case 1:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, strings.Join(set, ", "), "only one of exact, prefix, suffix or regex may be set"))
+	}
+
+	return allErrs
+}
+
+// ValidateDuration checks that the value is a duration of at least one
+// millisecond with millisecond precision.
+func ValidateDuration(value v1alpha1.Duration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value.Duration < time.Millisecond {
+		allErrs = append(allErrs, field.Invalid(fldPath, value.String(), "must be at least 1ms"))
+	} else if value.Duration%time.Millisecond != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value.String(), "only durations to millisecond precision are supported"))
+	}
+
+	return allErrs
+}
+
+// ValidatePercent checks that the value is between 0 and 100 inclusive.
+func ValidatePercent(value int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value < 0 || value > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+// ValidatePort checks that the value is a valid port number.
+func ValidatePort(value int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value < 1 || value > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be between 1 and 65535"))
+	}
+
+	return allErrs
+}
+
+// ValidateWildcardDomain checks that the host is a DNS name which may carry a
+// wildcard in its first label only, e.g. "*", "*.example.com" or
+// "reviews.default.svc.cluster.local".
+func ValidateWildcardDomain(host string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if host == "" {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	if len(host) > 255 {
+		return append(allErrs, field.TooLong(fldPath, host, 255))
+	}
+
+	labels := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if !wildcardLabelRegexp.MatchString(labels[0]) || len(labels[0]) > 63 {
+		return append(allErrs, field.Invalid(fldPath, host, fmt.Sprintf("label %q is invalid, only the first label may contain a wildcard prefix", labels[0])))
+	}
+	for _, label := range labels[1:] {
+		if !labelRegexp.MatchString(label) || len(label) > 63 {
+			return append(allErrs, field.Invalid(fldPath, host, fmt.Sprintf("label %q is not a valid DNS label", label)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWildcardDomainOrIP is like ValidateWildcardDomain but accepts IP
+// addresses as well.
+func ValidateWildcardDomainOrIP(host string, fldPath *field.Path) field.ErrorList {
+	if net.ParseIP(host) != nil {
+		return field.ErrorList{}
+	}
+
+	return ValidateWildcardDomain(host, fldPath)
+}
+
+// ValidateIPOrCIDR checks that the value is an IP address or a CIDR block.
+func ValidateIPOrCIDR(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strings.Contains(value, "/") {
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, "must be a valid CIDR block"))
+		}
+	} else if net.ParseIP(value) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be a valid IP address"))
+	}
+
+	return allErrs
+}
+
+var (
+	labelRegexp         = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+	wildcardLabelRegexp = regexp.MustCompile(`^(\*|(\*|\*-)?[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?)$`)
+)
@@ -0,0 +1,265 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package decoder reads YAML or JSON manifests into the typed Istio objects.
+// Unlike the JSON decoding of the types, fields unknown to the types are not
+// silently dropped but reported with their path and line.
+package decoder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	yaml "go.yaml.in/yaml/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kjson "sigs.k8s.io/json"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/banzaicloud/istio-client-go/pkg/client/clientset/versioned/scheme"
+)
+
+// Mode selects how unknown fields are handled.
+type Mode int
+
+const (
+	// Strict fails decoding if any document has unknown fields.
+	Strict Mode = iota
+	// Warn decodes documents with unknown fields and reports the fields on
+	// the documents.
+	Warn
+)
+
+// UnknownField is a field of a document that its type does not have.
+type UnknownField struct {
+	// Document is the index of the document in the stream.
+	Document int
+	// Path is the path of the field, e.g. spec.http[0].retires.
+	Path string
+	// Line is the line of the field in the stream, starting from 1.
+	Line int
+}
+
+func (f UnknownField) String() string {
+	return fmt.Sprintf("document %d, line %d: unknown field %q", f.Document, f.Line, f.Path)
+}
+
+// UnknownFieldsError is returned in strict mode when documents have unknown
+// fields. It lists the unknown fields of all documents.
+type UnknownFieldsError struct {
+	Fields []UnknownField
+}
+
+func (e *UnknownFieldsError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.String())
+	}
+
+	return strings.Join(fields, "\n")
+}
+
+// Document is a decoded document of a stream.
+type Document struct {
+	// Index is the index of the document in the stream.
+	Index int
+	// Line is the line the document starts on, starting from 1.
+	Line int
+	// Object is the decoded object.
+	Object runtime.Object
+	// UnknownFields are the fields of the document that were dropped, sorted
+	// by line. Always empty in strict mode.
+	UnknownFields []UnknownField
+}
+
+// Decoder decodes multi-document YAML or JSON streams into the types
+// registered in Scheme.
+type Decoder struct {
+	Scheme *runtime.Scheme
+	Mode   Mode
+	// Unstructured makes documents of kinds not registered in Scheme decode
+	// into *unstructured.Unstructured instead of failing.
+	Unstructured bool
+}
+
+// New returns a decoder in the given mode for the Istio types of the
+// clientset scheme.
+func New(mode Mode) *Decoder {
+	return &Decoder{
+		Scheme: scheme.Scheme,
+		Mode:   mode,
+	}
+}
+
+// Decode decodes every document of the stream. Empty documents are skipped,
+// but counted in the document indexes.
+//
+// In strict mode an *UnknownFieldsError is returned if any document has
+// unknown fields, after all documents are decoded so that every unknown
+// field is reported at once.
+func (d *Decoder) Decode(r io.Reader) ([]Document, error) {
+	var documents []Document
+	var unknownFields []UnknownField
+
+	yamlDecoder := yaml.NewDecoder(r)
+	for index := 0; ; index++ {
+		var node yaml.Node
+		if err := yamlDecoder.Decode(&node); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("document %d: %w", index, err)
+		}
+		if isEmpty(&node) {
+			continue
+		}
+
+		document, err := d.decodeNode(index, &node)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", index, err)
+		}
+		if d.Mode == Strict {
+			unknownFields = append(unknownFields, document.UnknownFields...)
+			document.UnknownFields = nil
+		}
+		documents = append(documents, document)
+	}
+
+	if len(unknownFields) > 0 {
+		return nil, &UnknownFieldsError{Fields: unknownFields}
+	}
+
+	return documents, nil
+}
+
+func (d *Decoder) decodeNode(index int, node *yaml.Node) (Document, error) {
+	document := Document{
+		Index: index,
+		Line:  node.Content[0].Line,
+	}
+
+	data, err := toJSON(node)
+	if err != nil {
+		return document, err
+	}
+
+	typeMeta := runtime.TypeMeta{}
+	if err := kjson.UnmarshalCaseSensitivePreserveInts(data, &typeMeta); err != nil {
+		return document, err
+	}
+	if typeMeta.APIVersion == "" || typeMeta.Kind == "" {
+		return document, errors.New("apiVersion and kind are required")
+	}
+	obj, err := d.Scheme.New(schema.FromAPIVersionAndKind(typeMeta.APIVersion, typeMeta.Kind))
+	if runtime.IsNotRegisteredError(err) && d.Unstructured {
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(data); err != nil {
+			return document, err
+		}
+		document.Object = u
+
+		return document, nil
+	} else if err != nil {
+		return document, err
+	}
+
+	strictErrs, err := kjson.UnmarshalStrict(data, obj, kjson.DisallowUnknownFields)
+	if err != nil {
+		return document, err
+	}
+	for _, strictErr := range strictErrs {
+		var fieldErr kjson.FieldError
+		if !errors.As(strictErr, &fieldErr) {
+			return document, strictErr
+		}
+		document.UnknownFields = append(document.UnknownFields, UnknownField{
+			Document: index,
+			Path:     fieldErr.FieldPath(),
+			Line:     line(node, fieldErr.FieldPath()),
+		})
+	}
+	sort.SliceStable(document.UnknownFields, func(i, j int) bool {
+		return document.UnknownFields[i].Line < document.UnknownFields[j].Line
+	})
+	document.Object = obj
+
+	return document, nil
+}
+
+// toJSON converts the document to JSON the way Kubernetes reads YAML.
+func toJSON(node *yaml.Node) ([]byte, error) {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigsyaml.YAMLToJSON(data)
+}
+
+func isEmpty(node *yaml.Node) bool {
+	if node.Kind != yaml.DocumentNode || len(node.Content) == 0 {
+		return true
+	}
+	content := node.Content[0]
+
+	return content.Kind == yaml.ScalarNode && content.Tag == "!!null"
+}
+
+// line returns the line of the field at the path in the document, e.g.
+// spec.http[0].retires. If the field cannot be found, the line of its
+// closest parent is returned.
+func line(node *yaml.Node, path string) int {
+	node = node.Content[0]
+	for path != "" {
+		switch node.Kind {
+		case yaml.AliasNode:
+			node = node.Alias
+		case yaml.MappingNode:
+			// keys may contain dots, so pick the longest one the path
+			// continues with
+			key, value := -1, -1
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				k := node.Content[i].Value
+				if !strings.HasPrefix(path, k) || (len(path) > len(k) && path[len(k)] != '.' && path[len(k)] != '[') {
+					continue
+				}
+				if key == -1 || len(k) > len(node.Content[key].Value) {
+					key, value = i, i+1
+				}
+			}
+			if key == -1 {
+				return node.Line
+			}
+			path = strings.TrimPrefix(path[len(node.Content[key].Value):], ".")
+			if path == "" {
+				return node.Content[key].Line
+			}
+			node = node.Content[value]
+		case yaml.SequenceNode:
+			var i int
+			if _, err := fmt.Sscanf(path, "[%d]", &i); err != nil || i < 0 || i >= len(node.Content) {
+				return node.Line
+			}
+			path = strings.TrimPrefix(path[strings.Index(path, "]")+1:], ".")
+			node = node.Content[i]
+		default:
+			return node.Line
+		}
+	}
+
+	return node.Line
+}
@@ -0,0 +1,163 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decoder
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+const virtualService = `apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: reviews
+spec:
+  hosts:
+  - reviews
+  http:
+  - route:
+    - destination:
+        host: reviews
+    retires:
+      attempts: 3
+  tcp:
+  - route:
+    - destination:
+        host: reviews
+        subset.name: v1
+`
+
+func TestDecodeUnknownFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		fields   []UnknownField
+	}{
+		{
+			name:     "known fields",
+			manifest: "apiVersion: networking.istio.io/v1alpha3\nkind: Gateway\nmetadata:\n  name: ingress\nspec:\n  selector:\n    istio: ingressgateway\n",
+		},
+		{
+			name:     "fields in lists",
+			manifest: virtualService,
+			fields: []UnknownField{
+				{Document: 0, Path: "spec.http[0].retires", Line: 12},
+				{Document: 0, Path: "spec.tcp[0].route[0].destination.subset.name", Line: 18},
+			},
+		},
+		{
+			name:     "fields of later documents",
+			manifest: "---\napiVersion: networking.istio.io/v1alpha3\nkind: Gateway\nmetadata:\n  name: ingress\n---\napiVersion: networking.istio.io/v1alpha3\nkind: Gateway\nmetadata:\n  name: egress\n  label: egress\n",
+			fields:   []UnknownField{{Document: 1, Path: "metadata.label", Line: 11}},
+		},
+		{
+			name:     "json",
+			manifest: `{"apiVersion": "networking.istio.io/v1alpha3", "kind": "Gateway", "spec": {"server": []}}`,
+			fields:   []UnknownField{{Document: 0, Path: "spec.server", Line: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := New(Warn).Decode(strings.NewReader(tt.manifest))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fields []UnknownField
+			for _, document := range documents {
+				fields = append(fields, document.UnknownFields...)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("warn: expected %v, got %v", tt.fields, fields)
+			}
+
+			_, err = New(Strict).Decode(strings.NewReader(tt.manifest))
+			if tt.fields == nil {
+				if err != nil {
+					t.Errorf("strict: expected no error, got %v", err)
+				}
+				return
+			}
+			var unknownFieldsErr *UnknownFieldsError
+			if !errors.As(err, &unknownFieldsErr) {
+				t.Fatalf("strict: expected an UnknownFieldsError, got %v", err)
+			}
+			if !reflect.DeepEqual(unknownFieldsErr.Fields, tt.fields) {
+				t.Errorf("strict: expected %v, got %v", tt.fields, unknownFieldsErr.Fields)
+			}
+		})
+	}
+}
+
+func TestDecodeDocuments(t *testing.T) {
+	manifest := "# comment only\n---\n" + virtualService + "---\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"
+
+	documents, err := (&Decoder{Scheme: New(Warn).Scheme, Mode: Warn, Unstructured: true}).Decode(strings.NewReader(manifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(documents) != 2 {
+		t.Fatalf("expected 2 documents, got %d", len(documents))
+	}
+
+	if documents[0].Index != 0 || documents[0].Line != 3 {
+		t.Errorf("expected the VirtualService to be document 0 on line 3, got %d on line %d", documents[0].Index, documents[0].Line)
+	}
+	vs, ok := documents[0].Object.(*v1alpha3.VirtualService)
+	if !ok {
+		t.Fatalf("expected a VirtualService, got %T", documents[0].Object)
+	}
+	if vs.Name != "reviews" || vs.Spec.HTTP[0].Route[0].Destination.Host != "reviews" {
+		t.Errorf("the VirtualService was not decoded: %+v", vs)
+	}
+
+	if documents[1].Index != 2 || documents[1].Line != 23 {
+		t.Errorf("expected the ConfigMap to be document 2 on line 23, got %d on line %d", documents[1].Index, documents[1].Line)
+	}
+	if u, ok := documents[1].Object.(*unstructured.Unstructured); !ok || u.GetName() != "config" {
+		t.Errorf("expected the ConfigMap to be decoded as unstructured, got %#v", documents[1].Object)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{name: "unregistered kind", manifest: "apiVersion: v1\nkind: ConfigMap\n", err: "document 0: "},
+		{name: "no kind", manifest: "apiVersion: networking.istio.io/v1alpha3\n", err: "document 0: apiVersion and kind are required"},
+		{name: "invalid yaml", manifest: "---\n---\nkind: [\n", err: "document 1: "},
+		{name: "wrong type", manifest: "apiVersion: networking.istio.io/v1alpha3\nkind: Gateway\nspec:\n  servers: {}\n", err: "document 0: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := New(Warn).Decode(strings.NewReader(tt.manifest))
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("expected an error starting with %q, got %v", tt.err, err)
+			}
+			if documents != nil {
+				t.Errorf("expected no documents, got %v", documents)
+			}
+		})
+	}
+}
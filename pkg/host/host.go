@@ -0,0 +1,182 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package host parses and compares the host names used across Istio
+// resources, e.g. the hosts of virtual services and service entries, and the
+// namespace/host form of gateway servers and sidecar egress listeners.
+package host
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultClusterDomain is the DNS domain of Kubernetes clusters unless
+// configured otherwise.
+const DefaultClusterDomain = "cluster.local"
+
+// Namespace scopes of a Host besides namespace names.
+const (
+	// AnyNamespace selects hosts of every namespace.
+	AnyNamespace = "*"
+	// CurrentNamespace selects hosts of the namespace of the object declaring
+	// the host.
+	CurrentNamespace = "."
+	// NoNamespace selects no services, e.g. "~/*" in the egress listener of
+	// a Sidecar imports nothing.
+	NoNamespace = "~"
+)
+
+// Name is a lowercase host name which may be a wildcard. "*" matches every
+// name, and "*.example.com" every name ending with ".example.com", but not
+// "example.com" itself.
+type Name string
+
+// NewName returns the name of the host, which is case insensitive.
+func NewName(host string) Name {
+	return Name(strings.ToLower(host))
+}
+
+// IsWildcard reports whether the name is a wildcard.
+func (n Name) IsWildcard() bool {
+	return strings.HasPrefix(string(n), "*")
+}
+
+// Matches reports whether the host name, which must not be a wildcard, is
+// one of the names of n.
+func (n Name) Matches(host string) bool {
+	return n.Specificity(host) >= 0
+}
+
+// Subsumes reports whether every name matched by o is matched by n as well.
+func (n Name) Subsumes(o Name) bool {
+	if !n.IsWildcard() {
+		return n == o
+	}
+
+	return strings.HasSuffix(string(o), string(n[1:]))
+}
+
+// Intersects reports whether n and o have any name in common.
+func (n Name) Intersects(o Name) bool {
+	return n.Subsumes(o) || o.Subsumes(n)
+}
+
+// Specificity returns how specifically n matches the host name, or -1 if it
+// does not match. Exact matches beat every wildcard, and wildcards with a
+// longer suffix beat shorter ones.
+func (n Name) Specificity(host string) int {
+	host = strings.ToLower(host)
+	if string(n) == host {
+		return len(host) + 1
+	}
+	if n.IsWildcard() && strings.HasSuffix(host, string(n[1:])) {
+		return len(n) - 1
+	}
+
+	return -1
+}
+
+// FQDN expands a short name to the fully qualified name of the service in the
+// namespace, the way Istio does for names without dots. Other names are
+// returned unchanged. The cluster domain is DefaultClusterDomain if empty.
+func (n Name) FQDN(namespace, clusterDomain string) Name {
+	if n.IsWildcard() || strings.Contains(string(n), ".") {
+		return n
+	}
+	if clusterDomain == "" {
+		clusterDomain = DefaultClusterDomain
+	}
+
+	return n + Name("."+namespace+".svc."+clusterDomain)
+}
+
+// Host is a host name scoped to the namespaces whose services it selects.
+type Host struct {
+	// Namespace is a namespace name, AnyNamespace, CurrentNamespace or
+	// NoNamespace.
+	Namespace string
+	Name      Name
+}
+
+// Parse parses a host in the namespace/host form. Hosts without a namespace
+// are in AnyNamespace, as they are for gateway servers.
+func Parse(host string) (Host, error) {
+	parts := strings.SplitN(host, "/", 2)
+	if len(parts) == 1 {
+		parts = []string{AnyNamespace, parts[0]}
+	}
+	if parts[0] == "" {
+		return Host{}, fmt.Errorf("host %q: namespace must not be empty", host)
+	}
+	if parts[1] == "" {
+		return Host{}, fmt.Errorf("host %q: host name must not be empty", host)
+	}
+
+	return Host{
+		Namespace: parts[0],
+		Name:      NewName(parts[1]),
+	}, nil
+}
+
+func (h Host) String() string {
+	return h.Namespace + "/" + string(h.Name)
+}
+
+// Resolve returns the host with CurrentNamespace replaced by the namespace of
+// the object declaring it.
+func (h Host) Resolve(namespace string) Host {
+	if h.Namespace == CurrentNamespace {
+		h.Namespace = namespace
+	}
+
+	return h
+}
+
+// InNamespace reports whether the host selects services of the namespace.
+// Hosts in CurrentNamespace must be resolved first.
+func (h Host) InNamespace(namespace string) bool {
+	return h.Namespace == AnyNamespace || (h.Namespace != NoNamespace && h.Namespace == namespace)
+}
+
+// Matches reports whether the host selects the service with the host name,
+// which must not be a wildcard, in the namespace.
+func (h Host) Matches(namespace, host string) bool {
+	return h.InNamespace(namespace) && h.Name.Matches(host)
+}
+
+// Subsumes reports whether every service selected by o is selected by h as
+// well.
+func (h Host) Subsumes(o Host) bool {
+	if o.Namespace == NoNamespace {
+		return true
+	}
+	if h.Namespace != AnyNamespace && h.Namespace != o.Namespace {
+		return false
+	}
+
+	return h.Name.Subsumes(o.Name)
+}
+
+// Intersects reports whether h and o select any service in common.
+func (h Host) Intersects(o Host) bool {
+	if h.Namespace == NoNamespace || o.Namespace == NoNamespace {
+		return false
+	}
+	if h.Namespace != AnyNamespace && o.Namespace != AnyNamespace && h.Namespace != o.Namespace {
+		return false
+	}
+
+	return h.Name.Intersects(o.Name)
+}
@@ -0,0 +1,182 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host
+
+import (
+	"testing"
+)
+
+func TestNameMatches(t *testing.T) {
+	tests := []struct {
+		name        Name
+		host        string
+		specificity int
+	}{
+		{name: "reviews.default.svc.cluster.local", host: "reviews.default.svc.cluster.local", specificity: 34},
+		{name: NewName("Reviews.Default.svc.cluster.local"), host: "reviews.default.SVC.cluster.local", specificity: 34},
+		{name: "reviews.default.svc.cluster.local", host: "ratings.default.svc.cluster.local", specificity: -1},
+		{name: "*.default.svc.cluster.local", host: "reviews.default.svc.cluster.local", specificity: 26},
+		{name: "*.svc.cluster.local", host: "reviews.default.svc.cluster.local", specificity: 18},
+		{name: "*.default.svc.cluster.local", host: "default.svc.cluster.local", specificity: -1},
+		{name: "*.example.com", host: "example.com", specificity: -1},
+		{name: "*", host: "example.com", specificity: 0},
+	}
+
+	for _, tt := range tests {
+		if specificity := tt.name.Specificity(tt.host); specificity != tt.specificity {
+			t.Errorf("%s against %s: expected specificity %d, got %d", tt.name, tt.host, tt.specificity, specificity)
+		}
+		if matches := tt.name.Matches(tt.host); matches != (tt.specificity >= 0) {
+			t.Errorf("%s against %s: expected %t, got %t", tt.name, tt.host, tt.specificity >= 0, matches)
+		}
+	}
+}
+
+func TestNameSubsumes(t *testing.T) {
+	tests := []struct {
+		n, o       Name
+		subsumes   bool
+		intersects bool
+	}{
+		{n: "example.com", o: "example.com", subsumes: true, intersects: true},
+		{n: "example.com", o: "api.example.com", subsumes: false, intersects: false},
+		{n: "*.example.com", o: "api.example.com", subsumes: true, intersects: true},
+		{n: "api.example.com", o: "*.example.com", subsumes: false, intersects: true},
+		{n: "*.example.com", o: "*.api.example.com", subsumes: true, intersects: true},
+		{n: "*.api.example.com", o: "*.example.com", subsumes: false, intersects: true},
+		{n: "*.example.com", o: "example.com", subsumes: false, intersects: false},
+		{n: "*.example.com", o: "*.example.org", subsumes: false, intersects: false},
+		{n: "*", o: "*.example.com", subsumes: true, intersects: true},
+		{n: "*.example.com", o: "*", subsumes: false, intersects: true},
+	}
+
+	for _, tt := range tests {
+		if subsumes := tt.n.Subsumes(tt.o); subsumes != tt.subsumes {
+			t.Errorf("%s subsumes %s: expected %t, got %t", tt.n, tt.o, tt.subsumes, subsumes)
+		}
+		if intersects := tt.n.Intersects(tt.o); intersects != tt.intersects {
+			t.Errorf("%s intersects %s: expected %t, got %t", tt.n, tt.o, tt.intersects, intersects)
+		}
+	}
+}
+
+func TestNameFQDN(t *testing.T) {
+	tests := []struct {
+		name          Name
+		clusterDomain string
+		fqdn          Name
+	}{
+		{name: "reviews", fqdn: "reviews.default.svc.cluster.local"},
+		{name: "reviews", clusterDomain: "example.org", fqdn: "reviews.default.svc.example.org"},
+		{name: "reviews.other", fqdn: "reviews.other"},
+		{name: "reviews.other.svc.cluster.local", fqdn: "reviews.other.svc.cluster.local"},
+		{name: "*", fqdn: "*"},
+		{name: "*.example.com", fqdn: "*.example.com"},
+	}
+
+	for _, tt := range tests {
+		if fqdn := tt.name.FQDN("default", tt.clusterDomain); fqdn != tt.fqdn {
+			t.Errorf("%s in %q: expected %s, got %s", tt.name, tt.clusterDomain, tt.fqdn, fqdn)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected Host
+		err      bool
+	}{
+		{host: "Reviews.Default.svc.cluster.local", expected: Host{Namespace: AnyNamespace, Name: "reviews.default.svc.cluster.local"}},
+		{host: "default/reviews", expected: Host{Namespace: "default", Name: "reviews"}},
+		{host: "./*", expected: Host{Namespace: CurrentNamespace, Name: "*"}},
+		{host: "~/*", expected: Host{Namespace: NoNamespace, Name: "*"}},
+		{host: "*/*.example.com", expected: Host{Namespace: AnyNamespace, Name: "*.example.com"}},
+		{host: "/reviews", err: true},
+		{host: "default/", err: true},
+		{host: "", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			h, err := Parse(tt.host)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected an error: %t, got %v", tt.err, err)
+			}
+			if h != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, h)
+			}
+		})
+	}
+}
+
+func TestHostMatches(t *testing.T) {
+	tests := []struct {
+		host      Host
+		namespace string
+		matches   bool
+	}{
+		{host: Host{Namespace: AnyNamespace, Name: "reviews.default.svc.cluster.local"}, namespace: "default", matches: true},
+		{host: Host{Namespace: AnyNamespace, Name: "*"}, namespace: "other", matches: true},
+		{host: Host{Namespace: "default", Name: "*"}, namespace: "default", matches: true},
+		{host: Host{Namespace: "default", Name: "*"}, namespace: "other", matches: false},
+		{host: Host{Namespace: "default", Name: "ratings.default.svc.cluster.local"}, namespace: "default", matches: false},
+		{host: Host{Namespace: NoNamespace, Name: "*"}, namespace: "default", matches: false},
+		{host: Host{Namespace: CurrentNamespace, Name: "*"}, namespace: "default", matches: false},
+		{host: Host{Namespace: CurrentNamespace, Name: "*"}.Resolve("default"), namespace: "default", matches: true},
+		{host: Host{Namespace: "other", Name: "*"}.Resolve("default"), namespace: "other", matches: true},
+	}
+
+	for _, tt := range tests {
+		if matches := tt.host.Matches(tt.namespace, "reviews.default.svc.cluster.local"); matches != tt.matches {
+			t.Errorf("%s in %s: expected %t, got %t", tt.host, tt.namespace, tt.matches, matches)
+		}
+	}
+}
+
+func TestHostSubsumes(t *testing.T) {
+	tests := []struct {
+		h, o       string
+		subsumes   bool
+		intersects bool
+	}{
+		{h: "*/*", o: "default/reviews", subsumes: true, intersects: true},
+		{h: "default/reviews", o: "*/*", subsumes: false, intersects: true},
+		{h: "default/*", o: "default/reviews", subsumes: true, intersects: true},
+		{h: "default/*", o: "other/reviews", subsumes: false, intersects: false},
+		{h: "default/*.example.com", o: "*/api.example.com", subsumes: false, intersects: true},
+		{h: "default/*.example.com", o: "default/*.example.org", subsumes: false, intersects: false},
+		{h: "default/reviews", o: "~/*", subsumes: true, intersects: false},
+		{h: "~/*", o: "~/*", subsumes: true, intersects: false},
+		{h: "~/*", o: "default/reviews", subsumes: false, intersects: false},
+	}
+
+	for _, tt := range tests {
+		h, err := Parse(tt.h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o, err := Parse(tt.o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if subsumes := h.Subsumes(o); subsumes != tt.subsumes {
+			t.Errorf("%s subsumes %s: expected %t, got %t", h, o, tt.subsumes, subsumes)
+		}
+		if intersects := h.Intersects(o); intersects != tt.intersects {
+			t.Errorf("%s intersects %s: expected %t, got %t", h, o, tt.intersects, intersects)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loader reads directories and streams of mixed manifests, e.g. the
+// output of helm template, and sorts the Istio objects in them by kind.
+package loader
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/banzaicloud/istio-client-go/pkg/decoder"
+)
+
+// Extensions are the extensions of the files read from directories.
+var Extensions = []string{".yaml", ".yml", ".json"}
+
+// Source is where an object was read from.
+type Source struct {
+	// File is the name of the file, or the name given for a stream.
+	File string
+	// Document is the index of the document in the file.
+	Document int
+	// Line is the line the document starts on, starting from 1.
+	Line int
+}
+
+func (s Source) String() string {
+	return fmt.Sprintf("%s: document %d", s.File, s.Document)
+}
+
+// Object is an object read from a manifest.
+type Object struct {
+	Source Source
+	Object runtime.Object
+}
+
+// UnknownField is a field dropped from a document in warn mode.
+type UnknownField struct {
+	File string
+	decoder.UnknownField
+}
+
+func (f UnknownField) String() string {
+	return fmt.Sprintf("%s: %s", f.File, f.UnknownField)
+}
+
+// Result holds the objects read by a Loader.
+type Result struct {
+	// Objects are the Istio objects by kind, in the order they were read.
+	Objects map[schema.GroupVersionKind][]Object
+	// Unstructured are the documents of other kinds, when passed through.
+	Unstructured []Object
+	// UnknownFields are the fields dropped in warn mode.
+	UnknownFields []UnknownField
+}
+
+// Kinds returns the kinds of the Istio objects read, sorted.
+func (r *Result) Kinds() []schema.GroupVersionKind {
+	kinds := make([]schema.GroupVersionKind, 0, len(r.Objects))
+	for gvk := range r.Objects {
+		kinds = append(kinds, gvk)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].String() < kinds[j].String()
+	})
+
+	return kinds
+}
+
+// Loader reads manifests with Decoder. Documents of kinds not registered in
+// the scheme of the decoder are skipped, unless PassThrough is set.
+type Loader struct {
+	Decoder *decoder.Decoder
+	// PassThrough keeps the documents of other kinds as
+	// *unstructured.Unstructured in Result.Unstructured.
+	PassThrough bool
+
+	result *Result
+}
+
+// New returns a loader decoding the Istio types in the given mode.
+func New(mode decoder.Mode) *Loader {
+	return &Loader{
+		Decoder: decoder.New(mode),
+	}
+}
+
+// LoadDir reads every file with one of the Extensions in the directory and
+// its subdirectories, in lexical order.
+func (l *Loader) LoadDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !hasExtension(path) {
+			return nil
+		}
+
+		return l.LoadFile(path)
+	})
+}
+
+// LoadFile reads the file.
+func (l *Loader) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return l.Load(path, f)
+}
+
+// Load reads the stream. The name is used in errors and sources, e.g. "-"
+// for the standard input.
+func (l *Loader) Load(name string, r io.Reader) error {
+	d := *l.Decoder
+	d.Unstructured = true
+	documents, err := d.Decode(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+
+	result := l.Result()
+	for _, document := range documents {
+		object := Object{
+			Source: Source{
+				File:     name,
+				Document: document.Index,
+				Line:     document.Line,
+			},
+			Object: document.Object,
+		}
+		for _, f := range document.UnknownFields {
+			result.UnknownFields = append(result.UnknownFields, UnknownField{
+				File:         name,
+				UnknownField: f,
+			})
+		}
+		if _, ok := document.Object.(*unstructured.Unstructured); ok {
+			if l.PassThrough {
+				result.Unstructured = append(result.Unstructured, object)
+			}
+			continue
+		}
+		gvk := document.Object.GetObjectKind().GroupVersionKind()
+		result.Objects[gvk] = append(result.Objects[gvk], object)
+	}
+
+	return nil
+}
+
+// Result returns the objects read so far.
+func (l *Loader) Result() *Result {
+	if l.result == nil {
+		l.result = &Result{
+			Objects: map[schema.GroupVersionKind][]Object{},
+		}
+	}
+
+	return l.result
+}
+
+func hasExtension(path string) bool {
+	ext := filepath.Ext(path)
+	for _, e := range Extensions {
+		if ext == e {
+			return true
+		}
+	}
+
+	return false
+}
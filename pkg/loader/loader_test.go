@@ -0,0 +1,184 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/banzaicloud/istio-client-go/pkg/decoder"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+const (
+	gateway   = "apiVersion: networking.istio.io/v1alpha3\nkind: Gateway\nmetadata:\n  name: ingress\n"
+	configMap = "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n"
+)
+
+var (
+	gatewayKind        = v1alpha3.SchemeGroupVersion.WithKind("Gateway")
+	virtualServiceKind = v1alpha3.SchemeGroupVersion.WithKind("VirtualService")
+	serviceEntryKind   = v1alpha3.SchemeGroupVersion.WithKind("ServiceEntry")
+)
+
+func vs(name string) string {
+	return "apiVersion: networking.istio.io/v1alpha3\nkind: VirtualService\nmetadata:\n  name: " + name + "\n"
+}
+
+// sources returns the sources of the objects of the kind, relative to dir.
+func sources(t *testing.T, result *Result, gvk schema.GroupVersionKind, dir string) []string {
+	t.Helper()
+
+	sources := []string{}
+	for _, object := range result.Objects[gvk] {
+		if kind := object.Object.GetObjectKind().GroupVersionKind(); kind != gvk {
+			t.Errorf("%s holds a %s", gvk, kind)
+		}
+		source := object.Source
+		if dir != "" {
+			file, err := filepath.Rel(dir, source.File)
+			if err != nil {
+				t.Fatal(err)
+			}
+			source.File = filepath.ToSlash(file)
+		}
+		sources = append(sources, source.String())
+	}
+
+	return sources
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"b/routes.yml":          vs("reviews") + "---\n" + configMap + "---\n" + vs("ratings"),
+		"a/gateway.yaml":        gateway,
+		"a/nested/details.yaml": vs("details"),
+		"c/entry.json":          `{"apiVersion": "networking.istio.io/v1alpha3", "kind": "ServiceEntry", "metadata": {"name": "external"}}`,
+		"c/README.md":           gateway,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	l := New(decoder.Strict)
+	if err := l.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	result := l.Result()
+
+	if kinds := result.Kinds(); !reflect.DeepEqual(kinds, []schema.GroupVersionKind{gatewayKind, serviceEntryKind, virtualServiceKind}) {
+		t.Errorf("expected Gateway, ServiceEntry and VirtualService objects, got %v", kinds)
+	}
+	tests := []struct {
+		gvk     schema.GroupVersionKind
+		sources []string
+	}{
+		{gvk: gatewayKind, sources: []string{"a/gateway.yaml: document 0"}},
+		{gvk: serviceEntryKind, sources: []string{"c/entry.json: document 0"}},
+		{gvk: virtualServiceKind, sources: []string{"a/nested/details.yaml: document 0", "b/routes.yml: document 0", "b/routes.yml: document 2"}},
+	}
+	for _, tt := range tests {
+		if sources := sources(t, result, tt.gvk, dir); !reflect.DeepEqual(sources, tt.sources) {
+			t.Errorf("%s: expected %v, got %v", tt.gvk.Kind, tt.sources, sources)
+		}
+	}
+	if len(result.Unstructured) != 0 {
+		t.Errorf("expected other kinds to be skipped, got %d", len(result.Unstructured))
+	}
+}
+
+func TestLoadPassThrough(t *testing.T) {
+	tests := []struct {
+		name         string
+		passThrough  bool
+		unstructured []string
+	}{
+		{name: "skip", unstructured: []string{}},
+		{name: "pass through", passThrough: true, unstructured: []string{"-: document 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(decoder.Strict)
+			l.PassThrough = tt.passThrough
+			if err := l.Load("-", strings.NewReader(gateway+"---\n"+configMap)); err != nil {
+				t.Fatal(err)
+			}
+			result := l.Result()
+
+			unstructured := []string{}
+			for _, object := range result.Unstructured {
+				unstructured = append(unstructured, object.Source.String())
+			}
+			if !reflect.DeepEqual(unstructured, tt.unstructured) {
+				t.Errorf("expected %v, got %v", tt.unstructured, unstructured)
+			}
+			if sources := sources(t, result, gatewayKind, ""); !reflect.DeepEqual(sources, []string{"-: document 0"}) {
+				t.Errorf("expected the Gateway of the stream, got %v", sources)
+			}
+			if l.Decoder.Unstructured {
+				t.Error("the decoder of the loader was modified")
+			}
+		})
+	}
+}
+
+func TestLoadUnknownFields(t *testing.T) {
+	manifest := gateway + "  label: ingress\n"
+
+	l := New(decoder.Warn)
+	for _, name := range []string{"first.yaml", "second.yaml"} {
+		if err := l.Load(name, strings.NewReader(manifest)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{
+		`first.yaml: document 0, line 5: unknown field "metadata.label"`,
+		`second.yaml: document 0, line 5: unknown field "metadata.label"`,
+	}
+	fields := []string{}
+	for _, field := range l.Result().UnknownFields {
+		fields = append(fields, field.String())
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+	if objects := l.Result().Objects[gatewayKind]; len(objects) != 2 {
+		t.Errorf("expected the Gateways to be loaded in warn mode, got %d", len(objects))
+	}
+
+	l = New(decoder.Strict)
+	err := l.Load("first.yaml", strings.NewReader(manifest))
+	var unknownFieldsErr *decoder.UnknownFieldsError
+	if !errors.As(err, &unknownFieldsErr) || !strings.HasPrefix(err.Error(), "first.yaml: ") {
+		t.Errorf("expected an UnknownFieldsError of first.yaml, got %v", err)
+	}
+	if objects := l.Result().Objects[gatewayKind]; len(objects) != 0 {
+		t.Errorf("expected no Gateways to be loaded in strict mode, got %d", len(objects))
+	}
+}
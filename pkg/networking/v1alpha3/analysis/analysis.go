@@ -0,0 +1,408 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analysis checks the networking resources of a mesh as a whole:
+// the references between them, which the validation of single resources
+// cannot catch, and the configuration they add up to for each workload.
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// MeshGateway is the reserved gateway name of the sidecars in the mesh.
+const MeshGateway = "mesh"
+
+// Snapshot is the configuration of a mesh at a point in time.
+type Snapshot struct {
+	VirtualServices  []v1alpha3.VirtualService
+	DestinationRules []v1alpha3.DestinationRule
+	Gateways         []v1alpha3.Gateway
+	ServiceEntries   []v1alpha3.ServiceEntry
+	Sidecars         []v1alpha3.Sidecar
+	// PeerAuthentications set the mTLS mode of the workloads which the TLS
+	// settings of the destination rules are checked against.
+	PeerAuthentications []securityv1beta1.PeerAuthentication
+	// Services are the Kubernetes services of the cluster, which destinations
+	// may refer to besides the hosts of the service entries.
+	Services []corev1.Service
+	// ClusterDomain is the DNS domain of the cluster,
+	// host.DefaultClusterDomain if empty.
+	ClusterDomain string
+	// RootNamespace is the root configuration namespace of the mesh,
+	// DefaultRootNamespace if empty.
+	RootNamespace string
+}
+
+// Code identifies the kind of problem reported by a finding.
+type Code string
+
+const (
+	// UnknownSubset is reported for destination subsets not defined by the
+	// DestinationRule of the destination host.
+	UnknownSubset Code = "UnknownSubset"
+	// UnknownGateway is reported for gateways of a VirtualService which do not
+	// exist.
+	UnknownGateway Code = "UnknownGateway"
+	// UnknownHost is reported for destination hosts which are neither a
+	// Kubernetes service nor a host of a ServiceEntry.
+	UnknownHost Code = "UnknownHost"
+	// ConflictingHost is reported for hosts claimed by more than one
+	// VirtualService on the same gateway. The oldest VirtualService wins.
+	ConflictingHost Code = "ConflictingHost"
+	// UnmatchedEgressHost is reported for Sidecar egress hosts which select
+	// no service.
+	UnmatchedEgressHost Code = "UnmatchedEgressHost"
+	// MTLSConflict is reported for DestinationRule TLS settings which the
+	// workloads of the service reject because of their mTLS mode, e.g.
+	// DISABLE towards workloads requiring STRICT mTLS.
+	MTLSConflict Code = "MTLSConflict"
+)
+
+// Finding is a broken reference of an object.
+type Finding struct {
+	Code Code
+	// Object is the object holding the reference.
+	Object corev1.ObjectReference
+	// Field is the path of the reference, e.g.
+	// spec.http[0].route[1].destination.subset.
+	Field   string
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s %s/%s: %s: %s", f.Object.Kind, f.Object.Namespace, f.Object.Name, f.Field, f.Message)
+}
+
+// Analyze returns the broken references and conflicting settings between the
+// objects of the snapshot, sorted by object. The visibility of objects restricted by exportTo is not
+// taken into account.
+func Analyze(snapshot *Snapshot) []Finding {
+	a := newAnalyzer(snapshot)
+
+	for i := range a.virtualServices {
+		a.analyzeVirtualService(&a.virtualServices[i])
+	}
+	a.analyzeHostConflicts()
+	for i := range snapshot.Sidecars {
+		a.analyzeSidecar(&snapshot.Sidecars[i])
+	}
+	for i := range a.destinationRules {
+		a.analyzeMTLS(&a.destinationRules[i])
+	}
+
+	sort.SliceStable(a.findings, func(i, j int) bool {
+		x, y := a.findings[i].Object, a.findings[j].Object
+		if x.Kind != y.Kind {
+			return x.Kind < y.Kind
+		}
+		if x.Namespace != y.Namespace {
+			return x.Namespace < y.Namespace
+		}
+		return x.Name < y.Name
+	})
+
+	return a.findings
+}
+
+// Service is a host which can be routed to, either a Kubernetes service or a
+// host of a ServiceEntry.
+type Service struct {
+	Namespace string
+	Name      host.Name
+}
+
+type analyzer struct {
+	snapshot      *Snapshot
+	domain        string
+	rootNamespace string
+	// virtualServices and destinationRules are sorted from the oldest to
+	// the newest, the order in which Istio picks among them.
+	virtualServices  []v1alpha3.VirtualService
+	destinationRules []v1alpha3.DestinationRule
+	// gateways are the gateways by namespace/name.
+	gateways map[string]bool
+	// services are the Kubernetes services and the hosts of the service
+	// entries, routes refers to these and the hosts of the virtual services.
+	services []Service
+	routes   []Service
+
+	findings []Finding
+}
+
+func newAnalyzer(snapshot *Snapshot) *analyzer {
+	a := &analyzer{
+		snapshot:         snapshot,
+		domain:           snapshot.ClusterDomain,
+		rootNamespace:    snapshot.RootNamespace,
+		virtualServices:  append([]v1alpha3.VirtualService(nil), snapshot.VirtualServices...),
+		destinationRules: append([]v1alpha3.DestinationRule(nil), snapshot.DestinationRules...),
+		gateways:         map[string]bool{},
+	}
+	if a.rootNamespace == "" {
+		a.rootNamespace = DefaultRootNamespace
+	}
+	sort.SliceStable(a.virtualServices, func(i, j int) bool {
+		return older(&a.virtualServices[i].ObjectMeta, &a.virtualServices[j].ObjectMeta)
+	})
+	sort.SliceStable(a.destinationRules, func(i, j int) bool {
+		return older(&a.destinationRules[i].ObjectMeta, &a.destinationRules[j].ObjectMeta)
+	})
+
+	for i := range snapshot.Gateways {
+		gw := &snapshot.Gateways[i]
+		a.gateways[gw.Namespace+"/"+gw.Name] = true
+	}
+	for i := range snapshot.Services {
+		svc := &snapshot.Services[i]
+		a.services = append(a.services, Service{svc.Namespace, a.fqdn(svc.Name, svc.Namespace)})
+	}
+	for i := range snapshot.ServiceEntries {
+		se := &snapshot.ServiceEntries[i]
+		for _, name := range se.Spec.Hosts {
+			a.services = append(a.services, Service{se.Namespace, a.fqdn(name, se.Namespace)})
+		}
+	}
+	a.routes = append(a.routes, a.services...)
+	for i := range a.virtualServices {
+		vs := &a.virtualServices[i]
+		for _, name := range vs.Spec.Hosts {
+			a.routes = append(a.routes, Service{vs.Namespace, a.fqdn(name, vs.Namespace)})
+		}
+	}
+
+	return a
+}
+
+func (a *analyzer) analyzeVirtualService(vs *v1alpha3.VirtualService) {
+	specPath := field.NewPath("spec")
+
+	a.analyzeGateways(vs, vs.Spec.Gateways, specPath.Child("gateways"))
+	for i, route := range vs.Spec.HTTP {
+		routePath := specPath.Child("http").Index(i)
+		for j, destination := range route.Route {
+			if destination != nil {
+				a.analyzeDestination(vs, destination.Destination, routePath.Child("route").Index(j).Child("destination"))
+			}
+		}
+		a.analyzeDestination(vs, route.Mirror, routePath.Child("mirror"))
+	}
+	for i, route := range vs.Spec.TLS {
+		routePath := specPath.Child("tls").Index(i)
+		for j, match := range route.Match {
+			a.analyzeGateways(vs, match.Gateways, routePath.Child("match").Index(j).Child("gateways"))
+		}
+		for j, destination := range route.Route {
+			if destination != nil {
+				a.analyzeDestination(vs, destination.Destination, routePath.Child("route").Index(j).Child("destination"))
+			}
+		}
+	}
+	for i, route := range vs.Spec.TCP {
+		routePath := specPath.Child("tcp").Index(i)
+		for j, match := range route.Match {
+			a.analyzeGateways(vs, match.Gateways, routePath.Child("match").Index(j).Child("gateways"))
+		}
+		for j, destination := range route.Route {
+			if destination != nil {
+				a.analyzeDestination(vs, destination.Destination, routePath.Child("route").Index(j).Child("destination"))
+			}
+		}
+	}
+}
+
+func (a *analyzer) analyzeGateways(vs *v1alpha3.VirtualService, gateways []string, fldPath *field.Path) {
+	for i, gateway := range gateways {
+		if gateway == MeshGateway || a.gateways[qualifyGateway(gateway, vs.Namespace)] {
+			continue
+		}
+		a.report(UnknownGateway, virtualServiceReference(vs), fldPath.Index(i), fmt.Sprintf("gateway %q does not exist", gateway))
+	}
+}
+
+func (a *analyzer) analyzeDestination(vs *v1alpha3.VirtualService, destination *v1alpha3.Destination, fldPath *field.Path) {
+	if destination == nil || destination.Host == "" {
+		return
+	}
+	ref := virtualServiceReference(vs)
+	name := a.fqdn(destination.Host, vs.Namespace)
+
+	known := false
+	for _, svc := range a.services {
+		if svc.Name.Intersects(name) {
+			known = true
+			break
+		}
+	}
+	if !known {
+		a.report(UnknownHost, ref, fldPath.Child("host"), fmt.Sprintf("%q is neither a service nor a host of a ServiceEntry", name))
+	}
+
+	if destination.Subset == nil {
+		return
+	}
+	dr := a.destinationRule(name)
+	if dr == nil {
+		a.report(UnknownSubset, ref, fldPath.Child("subset"), fmt.Sprintf("no DestinationRule defines subsets for %q", name))
+		return
+	}
+	for _, subset := range dr.Spec.Subsets {
+		if subset.Name == *destination.Subset {
+			return
+		}
+	}
+	a.report(UnknownSubset, ref, fldPath.Child("subset"), fmt.Sprintf("subset %q is not defined by DestinationRule %s/%s", *destination.Subset, dr.Namespace, dr.Name))
+}
+
+// destinationRule returns the DestinationRule applied to the host: the one
+// with the most specific host, or the oldest one of those.
+func (a *analyzer) destinationRule(name host.Name) *v1alpha3.DestinationRule {
+	var best *v1alpha3.DestinationRule
+	bestSpecificity := -1
+	for i := range a.destinationRules {
+		dr := &a.destinationRules[i]
+		if specificity := a.fqdn(dr.Spec.Host, dr.Namespace).Specificity(string(name)); specificity > bestSpecificity {
+			best, bestSpecificity = dr, specificity
+		}
+	}
+
+	return best
+}
+
+// analyzeHostConflicts reports every host of a VirtualService which an older
+// VirtualService bound to the same gateway claims as well.
+func (a *analyzer) analyzeHostConflicts() {
+	owners := map[string]*v1alpha3.VirtualService{}
+	for i := range a.virtualServices {
+		vs := &a.virtualServices[i]
+		gateways := vs.Spec.Gateways
+		if len(gateways) == 0 {
+			gateways = []string{MeshGateway}
+		}
+		for _, gateway := range gateways {
+			if gateway != MeshGateway {
+				gateway = qualifyGateway(gateway, vs.Namespace)
+			}
+			for j, name := range vs.Spec.Hosts {
+				key := gateway + " " + string(a.fqdn(name, vs.Namespace))
+				owner, ok := owners[key]
+				if !ok {
+					owners[key] = vs
+					continue
+				}
+				if owner == vs {
+					continue
+				}
+				a.report(ConflictingHost, virtualServiceReference(vs), field.NewPath("spec", "hosts").Index(j),
+					fmt.Sprintf("host %q on gateway %q is also claimed by VirtualService %s/%s", name, gateway, owner.Namespace, owner.Name))
+			}
+		}
+	}
+}
+
+func (a *analyzer) analyzeSidecar(sidecar *v1alpha3.Sidecar) {
+	ref := corev1.ObjectReference{
+		APIVersion: v1alpha3.SchemeGroupVersion.String(),
+		Kind:       "Sidecar",
+		Namespace:  sidecar.Namespace,
+		Name:       sidecar.Name,
+	}
+	// "." refers to the namespace of each workload for root namespace
+	// defaults, so those hosts cannot be checked without the workloads
+	rootDefault := sidecar.Namespace == a.rootNamespace &&
+		(sidecar.Spec.WorkloadSelector == nil || len(sidecar.Spec.WorkloadSelector.Labels) == 0)
+
+	for i, listener := range sidecar.Spec.Egress {
+		if listener == nil {
+			continue
+		}
+		for j, egressHost := range listener.Hosts {
+			h, err := host.Parse(egressHost)
+			if err != nil || !strings.Contains(egressHost, "/") || h.Namespace == host.NoNamespace {
+				continue
+			}
+			if rootDefault && h.Namespace == host.CurrentNamespace {
+				continue
+			}
+			h = h.Resolve(sidecar.Namespace)
+
+			matched := false
+			for _, route := range a.routes {
+				if h.InNamespace(route.Namespace) && h.Name.Intersects(route.Name) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				fldPath := field.NewPath("spec", "egress").Index(i).Child("hosts").Index(j)
+				a.report(UnmatchedEgressHost, ref, fldPath, fmt.Sprintf("%q matches no service, ServiceEntry or VirtualService", egressHost))
+			}
+		}
+	}
+}
+
+func (a *analyzer) report(code Code, ref corev1.ObjectReference, fldPath *field.Path, message string) {
+	a.findings = append(a.findings, Finding{
+		Code:    code,
+		Object:  ref,
+		Field:   fldPath.String(),
+		Message: message,
+	})
+}
+
+// fqdn returns the fully qualified name of a host of an object in the
+// namespace.
+func (a *analyzer) fqdn(name, namespace string) host.Name {
+	return host.NewName(name).FQDN(namespace, a.domain)
+}
+
+func virtualServiceReference(vs *v1alpha3.VirtualService) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: v1alpha3.SchemeGroupVersion.String(),
+		Kind:       "VirtualService",
+		Namespace:  vs.Namespace,
+		Name:       vs.Name,
+	}
+}
+
+// qualifyGateway returns the namespace/name of a gateway referenced from the
+// namespace.
+func qualifyGateway(gateway, namespace string) string {
+	if strings.Contains(gateway, "/") {
+		return gateway
+	}
+
+	return namespace + "/" + gateway
+}
+
+func older(x, y *metav1.ObjectMeta) bool {
+	if !x.CreationTimestamp.Equal(&y.CreationTimestamp) {
+		return x.CreationTimestamp.Before(&y.CreationTimestamp)
+	}
+	if x.Namespace != y.Namespace {
+		return x.Namespace < y.Namespace
+	}
+
+	return x.Name < y.Name
+}
@@ -0,0 +1,266 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+var created = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func objectMeta(namespace, name string, age time.Duration) metav1.ObjectMeta {
+	return metav1.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: metav1.NewTime(created.Add(-age))}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func service(namespace, name string) corev1.Service {
+	return corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+func route(host, subset string) []*v1alpha3.HTTPRouteDestination {
+	destination := &v1alpha3.Destination{Host: host}
+	if subset != "" {
+		destination.Subset = stringPtr(subset)
+	}
+
+	return []*v1alpha3.HTTPRouteDestination{{Destination: destination}}
+}
+
+func virtualService(namespace, name string, spec v1alpha3.VirtualServiceSpec) v1alpha3.VirtualService {
+	return v1alpha3.VirtualService{ObjectMeta: objectMeta(namespace, name, 0), Spec: spec}
+}
+
+func sidecar(namespace, name string, hosts ...string) v1alpha3.Sidecar {
+	return v1alpha3.Sidecar{
+		ObjectMeta: objectMeta(namespace, name, 0),
+		Spec:       v1alpha3.SidecarSpec{Egress: []*v1alpha3.IstioEgressListener{{Hosts: hosts}}},
+	}
+}
+
+// findings returns the kind, namespace/name, field and code of the findings.
+func findings(snapshot *Snapshot) []string {
+	result := []string{}
+	for _, f := range Analyze(snapshot) {
+		result = append(result, f.Object.Kind+" "+f.Object.Namespace+"/"+f.Object.Name+" "+f.Field+" "+string(f.Code))
+	}
+
+	return result
+}
+
+func TestAnalyzeVirtualServices(t *testing.T) {
+	tests := []struct {
+		name            string
+		virtualServices []v1alpha3.VirtualService
+		clusterDomain   string
+		findings        []string
+	}{
+		{
+			name: "valid references",
+			virtualServices: []v1alpha3.VirtualService{virtualService("default", "reviews", v1alpha3.VirtualServiceSpec{
+				Hosts:    []string{"reviews"},
+				Gateways: []string{"ingress", "default/ingress", MeshGateway},
+				HTTP: []v1alpha3.HTTPRoute{{
+					Route:  route("reviews", "v1"),
+					Mirror: &v1alpha3.Destination{Host: "ratings"},
+				}},
+				TCP: []v1alpha3.TCPRoute{{
+					Route: []*v1alpha3.RouteDestination{{Destination: &v1alpha3.Destination{Host: "api.example.com"}}},
+				}},
+			})},
+		},
+		{
+			name: "unknown gateways",
+			virtualServices: []v1alpha3.VirtualService{virtualService("other", "reviews", v1alpha3.VirtualServiceSpec{
+				Gateways: []string{"ingress", "default/ingress", "default/egress"},
+				TLS: []v1alpha3.TLSRoute{{
+					Match: []v1alpha3.TLSMatchAttributes{{SniHosts: []string{"reviews"}, Gateways: []string{"egress"}}},
+				}},
+			})},
+			findings: []string{
+				"VirtualService other/reviews spec.gateways[0] UnknownGateway",
+				"VirtualService other/reviews spec.gateways[2] UnknownGateway",
+				"VirtualService other/reviews spec.tls[0].match[0].gateways[0] UnknownGateway",
+			},
+		},
+		{
+			name: "unknown hosts",
+			virtualServices: []v1alpha3.VirtualService{virtualService("other", "reviews", v1alpha3.VirtualServiceSpec{
+				HTTP: []v1alpha3.HTTPRoute{{
+					Route:  route("reviews.default.svc.cluster.local", ""),
+					Mirror: &v1alpha3.Destination{Host: "reviews"},
+				}},
+				TCP: []v1alpha3.TCPRoute{{
+					Route: []*v1alpha3.RouteDestination{
+						{Destination: &v1alpha3.Destination{Host: "*.example.com"}},
+						{Destination: &v1alpha3.Destination{Host: "example.com"}},
+					},
+				}},
+			})},
+			findings: []string{
+				"VirtualService other/reviews spec.http[0].mirror.host UnknownHost",
+				"VirtualService other/reviews spec.tcp[0].route[1].destination.host UnknownHost",
+			},
+		},
+		{
+			name:          "cluster domain",
+			clusterDomain: "example.org",
+			virtualServices: []v1alpha3.VirtualService{virtualService("default", "reviews", v1alpha3.VirtualServiceSpec{
+				HTTP: []v1alpha3.HTTPRoute{
+					{Route: route("reviews", "")},
+					{Route: route("reviews.default.svc.example.org", "")},
+					{Route: route("reviews.default.svc.cluster.local", "")},
+				},
+			})},
+			findings: []string{"VirtualService default/reviews spec.http[2].route[0].destination.host UnknownHost"},
+		},
+		{
+			name: "unknown subsets",
+			virtualServices: []v1alpha3.VirtualService{virtualService("default", "reviews", v1alpha3.VirtualServiceSpec{
+				HTTP: []v1alpha3.HTTPRoute{
+					{Route: route("reviews", "v2")},
+					{Route: route("ratings", "v1")},
+					{Route: route("details", "v3")},
+					{Route: route("details", "v2")},
+				},
+			})},
+			findings: []string{
+				"VirtualService default/reviews spec.http[0].route[0].destination.subset UnknownSubset",
+				"VirtualService default/reviews spec.http[1].route[0].destination.subset UnknownSubset",
+				"VirtualService default/reviews spec.http[2].route[0].destination.subset UnknownSubset",
+			},
+		},
+		{
+			name: "host claimed twice",
+			virtualServices: []v1alpha3.VirtualService{
+				virtualService("default", "newer", v1alpha3.VirtualServiceSpec{Hosts: []string{"ratings", "reviews.default.svc.cluster.local"}}),
+				{ObjectMeta: objectMeta("default", "older", time.Hour), Spec: v1alpha3.VirtualServiceSpec{Hosts: []string{"reviews"}}},
+				virtualService("default", "ingress", v1alpha3.VirtualServiceSpec{Hosts: []string{"reviews"}, Gateways: []string{"ingress"}}),
+				virtualService("other", "reviews", v1alpha3.VirtualServiceSpec{Hosts: []string{"reviews"}}),
+			},
+			findings: []string{"VirtualService default/newer spec.hosts[1] ConflictingHost"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &Snapshot{
+				VirtualServices: tt.virtualServices,
+				DestinationRules: []v1alpha3.DestinationRule{
+					{
+						ObjectMeta: objectMeta("default", "reviews", 0),
+						Spec:       v1alpha3.DestinationRuleSpec{Host: "reviews", Subsets: []v1alpha3.Subset{{Name: "v1"}}},
+					},
+					{
+						ObjectMeta: objectMeta("default", "all", 0),
+						Spec:       v1alpha3.DestinationRuleSpec{Host: "*.default.svc.cluster.local", Subsets: []v1alpha3.Subset{{Name: "v2"}}},
+					},
+				},
+				Gateways: []v1alpha3.Gateway{{ObjectMeta: objectMeta("default", "ingress", 0)}},
+				ServiceEntries: []v1alpha3.ServiceEntry{{
+					ObjectMeta: objectMeta("default", "api", 0),
+					Spec:       v1alpha3.ServiceEntrySpec{Hosts: []string{"api.example.com"}},
+				}},
+				Services:      []corev1.Service{service("default", "reviews"), service("default", "ratings"), service("default", "details")},
+				ClusterDomain: tt.clusterDomain,
+			}
+			if tt.findings == nil {
+				tt.findings = []string{}
+			}
+			if got := findings(snapshot); !reflect.DeepEqual(got, tt.findings) {
+				t.Errorf("expected %v, got %v", tt.findings, got)
+			}
+		})
+	}
+}
+
+func TestAnalyzeSidecarEgressHosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		sidecar  v1alpha3.Sidecar
+		findings []string
+	}{
+		{
+			name:    "matching hosts",
+			sidecar: sidecar("default", "default", "./reviews.default.svc.cluster.local", "default/ratings.default.svc.cluster.local", "*/api.example.com", "other/*", "istio-system/*"),
+		},
+		{
+			name:    "hosts of virtual services",
+			sidecar: sidecar("other", "default", "./*.example.org"),
+		},
+		{
+			name:    "hosts without a namespace or importing nothing are not checked",
+			sidecar: sidecar("default", "default", "details", "~/*"),
+		},
+		{
+			name:    "unmatched hosts",
+			sidecar: sidecar("default", "default", "./details", "other/reviews.default.svc.cluster.local", "*/*.example.net"),
+			findings: []string{
+				"Sidecar default/default spec.egress[0].hosts[0] UnmatchedEgressHost",
+				"Sidecar default/default spec.egress[0].hosts[1] UnmatchedEgressHost",
+				"Sidecar default/default spec.egress[0].hosts[2] UnmatchedEgressHost",
+			},
+		},
+		{
+			name:    "current namespace of a root namespace default",
+			sidecar: sidecar(DefaultRootNamespace, "default", "./details.default.svc.cluster.local", "./*"),
+		},
+		{
+			name: "current namespace of a root namespace workload",
+			sidecar: func() v1alpha3.Sidecar {
+				s := sidecar(DefaultRootNamespace, "ingress", "./details.default.svc.cluster.local")
+				s.Spec.WorkloadSelector = &v1alpha3.WorkloadSelector{Labels: map[string]string{"app": "ingress"}}
+				return s
+			}(),
+			findings: []string{"Sidecar istio-system/ingress spec.egress[0].hosts[0] UnmatchedEgressHost"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &Snapshot{
+				VirtualServices: []v1alpha3.VirtualService{
+					virtualService("other", "web", v1alpha3.VirtualServiceSpec{Hosts: []string{"web.example.org"}}),
+				},
+				ServiceEntries: []v1alpha3.ServiceEntry{{
+					ObjectMeta: objectMeta("default", "api", 0),
+					Spec:       v1alpha3.ServiceEntrySpec{Hosts: []string{"api.example.com"}},
+				}},
+				Services: []corev1.Service{
+					service("default", "reviews"),
+					service("default", "ratings"),
+					service("other", "details"),
+					service(DefaultRootNamespace, "istiod"),
+				},
+				Sidecars: []v1alpha3.Sidecar{tt.sidecar},
+			}
+			if tt.findings == nil {
+				tt.findings = []string{}
+			}
+			if got := findings(snapshot); !reflect.DeepEqual(got, tt.findings) {
+				t.Errorf("expected %v, got %v", tt.findings, got)
+			}
+		})
+	}
+}
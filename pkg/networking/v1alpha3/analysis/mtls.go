@@ -0,0 +1,125 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1/mtls"
+)
+
+// analyzeMTLS reports the TLS settings of the DestinationRule which conflict
+// with the effective mTLS mode of the workloads selected by the Kubernetes
+// services of its host. The settings of subsets are checked against the
+// workloads with the labels of the subset.
+func (a *analyzer) analyzeMTLS(dr *v1alpha3.DestinationRule) {
+	name := a.fqdn(dr.Spec.Host, dr.Namespace)
+	var services []*corev1.Service
+	for i := range a.snapshot.Services {
+		svc := &a.snapshot.Services[i]
+		if len(svc.Spec.Selector) > 0 && name.Intersects(a.fqdn(svc.Name, svc.Namespace)) {
+			services = append(services, svc)
+		}
+	}
+
+	specPath := field.NewPath("spec")
+	reported := map[string]bool{}
+	for _, svc := range services {
+		for _, port := range svc.Spec.Ports {
+			tls, tlsPath := tlsSettings(dr.Spec.TrafficPolicy, specPath.Child("trafficPolicy"), port.Port)
+			a.analyzePortTLS(dr, tls, tlsPath, svc, port, nil, reported)
+			for i := range dr.Spec.Subsets {
+				subset := &dr.Spec.Subsets[i]
+				// subsets inherit the settings they do not override
+				subsetTLS, subsetTLSPath := tlsSettings(subset.TrafficPolicy, specPath.Child("subsets").Index(i).Child("trafficPolicy"), port.Port)
+				if subsetTLS == nil {
+					subsetTLS, subsetTLSPath = tls, tlsPath
+				}
+				a.analyzePortTLS(dr, subsetTLS, subsetTLSPath, svc, port, subset.Labels, reported)
+			}
+		}
+	}
+}
+
+// tlsSettings returns the TLS settings of the traffic policy for the port
+// along with their path, or nil if there are none. Port level settings
+// replace the settings of the traffic policy.
+func tlsSettings(policy *v1alpha3.TrafficPolicy, fldPath *field.Path, port int32) (*v1alpha3.TLSSettings, *field.Path) {
+	if policy == nil {
+		return nil, nil
+	}
+	for i, portPolicy := range policy.PortLevelSettings {
+		if portPolicy.Port != nil && portPolicy.Port.Number == uint32(port) {
+			return portPolicy.TLS, fldPath.Child("portLevelSettings").Index(i).Child("tls")
+		}
+	}
+
+	return policy.TLS, fldPath.Child("tls")
+}
+
+func (a *analyzer) analyzePortTLS(dr *v1alpha3.DestinationRule, tls *v1alpha3.TLSSettings, tlsPath *field.Path,
+	svc *corev1.Service, port corev1.ServicePort, subsetLabels map[string]string, reported map[string]bool) {
+	if tls == nil {
+		return
+	}
+
+	workload := mtls.Workload{
+		Namespace: svc.Namespace,
+		Labels:    map[string]string{},
+		Port:      uint32(port.Port),
+	}
+	if port.TargetPort.Type == intstr.Int && port.TargetPort.IntVal > 0 {
+		workload.Port = uint32(port.TargetPort.IntVal)
+	}
+	for k, v := range svc.Spec.Selector {
+		workload.Labels[k] = v
+	}
+	for k, v := range subsetLabels {
+		workload.Labels[k] = v
+	}
+	resolver := mtls.Resolver{
+		RootNamespace: a.rootNamespace,
+		Policies:      a.snapshot.PeerAuthentications,
+	}
+	result := resolver.Resolve(workload)
+
+	strictConflict := result.Mode == securityv1beta1.MTLSModeStrict &&
+		(tls.Mode == v1alpha3.TLSmodeDisable || tls.Mode == v1alpha3.TLSmodeSimple)
+	disableConflict := result.Mode == securityv1beta1.MTLSModeDisable && tls.Mode == v1alpha3.TLSmodeIstioMutual
+	if !strictConflict && !disableConflict {
+		return
+	}
+	source := result.Source().Policy
+	message := fmt.Sprintf("TLS mode %s conflicts with the %s mTLS mode of port %d of service %s/%s, set by PeerAuthentication %s/%s",
+		tls.Mode, result.Mode, port.Port, svc.Namespace, svc.Name, source.Namespace, source.Name)
+	key := tlsPath.String() + " " + message
+	if reported[key] {
+		return
+	}
+	reported[key] = true
+
+	a.report(MTLSConflict, corev1.ObjectReference{
+		APIVersion: v1alpha3.SchemeGroupVersion.String(),
+		Kind:       "DestinationRule",
+		Namespace:  dr.Namespace,
+		Name:       dr.Name,
+	}, tlsPath, message)
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	securityv1beta1 "github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func peerAuthentication(namespace, name string, mode securityv1beta1.MTLSMode, labels map[string]string, ports map[uint32]securityv1beta1.MTLSMode) securityv1beta1.PeerAuthentication {
+	p := securityv1beta1.PeerAuthentication{
+		ObjectMeta: objectMeta(namespace, name, 0),
+		Spec:       securityv1beta1.PeerAuthenticationSpec{Mtls: &securityv1beta1.PeerAuthenticationMTLS{Mode: mode}},
+	}
+	if labels != nil {
+		p.Spec.Selector = &selector.WorkloadSelector{MatchLabels: labels}
+	}
+	for port, portMode := range ports {
+		if p.Spec.PortLevelMtls.Ports == nil {
+			p.Spec.PortLevelMtls.Ports = map[uint32]*securityv1beta1.PeerAuthenticationMTLS{}
+		}
+		p.Spec.PortLevelMtls.Ports[port] = &securityv1beta1.PeerAuthenticationMTLS{Mode: portMode}
+	}
+
+	return p
+}
+
+func tlsPolicy(mode v1alpha3.TLSmode) *v1alpha3.TrafficPolicy {
+	return &v1alpha3.TrafficPolicy{TrafficPolicyCommon: v1alpha3.TrafficPolicyCommon{TLS: &v1alpha3.TLSSettings{Mode: mode}}}
+}
+
+func TestAnalyzeMTLS(t *testing.T) {
+	reviews := service("default", "reviews")
+	reviews.Spec = corev1.ServiceSpec{
+		Selector: map[string]string{"app": "reviews"},
+		Ports: []corev1.ServicePort{
+			{Name: "http", Port: 80, TargetPort: intstr.FromInt(9080)},
+			{Name: "grpc", Port: 90, TargetPort: intstr.FromString("grpc")},
+		},
+	}
+	meshStrict := peerAuthentication(DefaultRootNamespace, "default", securityv1beta1.MTLSModeStrict, nil, nil)
+
+	tests := []struct {
+		name                string
+		peerAuthentications []securityv1beta1.PeerAuthentication
+		spec                v1alpha3.DestinationRuleSpec
+		findings            []string
+	}{
+		{
+			name:                "disable towards strict",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{meshStrict},
+			spec:                v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeDisable)},
+			// one finding per port of the service
+			findings: []string{
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+			},
+		},
+		{
+			name:                "simple towards strict",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{meshStrict},
+			spec:                v1alpha3.DestinationRuleSpec{Host: "*.default.svc.cluster.local", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeSimple)},
+			findings: []string{
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+			},
+		},
+		{
+			name:                "istio mutual towards strict",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{meshStrict},
+			spec:                v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeIstioMutual)},
+		},
+		{
+			name: "istio mutual towards disable",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{
+				peerAuthentication("default", "default", securityv1beta1.MTLSModeDisable, nil, nil),
+			},
+			spec: v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeIstioMutual)},
+			findings: []string{
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+			},
+		},
+		{
+			name:                "permissive",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{peerAuthentication("default", "default", securityv1beta1.MTLSModePermissive, nil, nil)},
+			spec:                v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeDisable)},
+		},
+		{
+			name: "target port of a workload port setting",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{
+				peerAuthentication("default", "reviews", securityv1beta1.MTLSModePermissive, map[string]string{"app": "reviews"},
+					map[uint32]securityv1beta1.MTLSMode{9080: securityv1beta1.MTLSModeStrict}),
+			},
+			spec:     v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeDisable)},
+			findings: []string{"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict"},
+		},
+		{
+			name:                "port level settings",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{meshStrict},
+			spec: v1alpha3.DestinationRuleSpec{Host: "reviews", TrafficPolicy: &v1alpha3.TrafficPolicy{
+				TrafficPolicyCommon: v1alpha3.TrafficPolicyCommon{TLS: &v1alpha3.TLSSettings{Mode: v1alpha3.TLSmodeIstioMutual}},
+				PortLevelSettings: []v1alpha3.PortTrafficPolicy{{
+					Port:                &v1alpha3.PortSelector{Number: 90},
+					TrafficPolicyCommon: v1alpha3.TrafficPolicyCommon{TLS: &v1alpha3.TLSSettings{Mode: v1alpha3.TLSmodeDisable}},
+				}},
+			}},
+			findings: []string{"DestinationRule default/reviews spec.trafficPolicy.portLevelSettings[0].tls MTLSConflict"},
+		},
+		{
+			name: "subsets",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{
+				peerAuthentication("default", "v2", securityv1beta1.MTLSModeStrict, map[string]string{"version": "v2"}, nil),
+			},
+			spec: v1alpha3.DestinationRuleSpec{
+				Host:          "reviews",
+				TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeDisable),
+				Subsets: []v1alpha3.Subset{
+					{Name: "v1", Labels: map[string]string{"version": "v1"}},
+					{Name: "v2", Labels: map[string]string{"version": "v2"}},
+					{Name: "v2-mutual", Labels: map[string]string{"version": "v2"}, TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeIstioMutual)},
+				},
+			},
+			findings: []string{
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+				"DestinationRule default/reviews spec.trafficPolicy.tls MTLSConflict",
+			},
+		},
+		{
+			name:                "services without a selector",
+			peerAuthentications: []securityv1beta1.PeerAuthentication{meshStrict},
+			spec:                v1alpha3.DestinationRuleSpec{Host: "ratings", TrafficPolicy: tlsPolicy(v1alpha3.TLSmodeDisable)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &Snapshot{
+				DestinationRules:    []v1alpha3.DestinationRule{{ObjectMeta: objectMeta("default", "reviews", 0), Spec: tt.spec}},
+				PeerAuthentications: tt.peerAuthentications,
+				Services:            []corev1.Service{reviews, service("default", "ratings")},
+			}
+			if tt.findings == nil {
+				tt.findings = []string{}
+			}
+			if got := findings(snapshot); !reflect.DeepEqual(got, tt.findings) {
+				t.Errorf("expected %v, got %v", tt.findings, got)
+			}
+		})
+	}
+}
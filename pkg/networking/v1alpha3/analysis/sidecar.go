@@ -0,0 +1,256 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// DefaultRootNamespace is the root configuration namespace of Istio unless
+// configured otherwise.
+const DefaultRootNamespace = "istio-system"
+
+// ExportToAnnotation restricts the namespaces a Kubernetes service is visible
+// in to a comma separated list, like the exportTo field of the networking
+// resources.
+const ExportToAnnotation = "networking.istio.io/exportTo"
+
+// Workload identifies the pods a Sidecar is resolved for.
+type Workload struct {
+	Namespace string
+	Labels    map[string]string
+}
+
+// EffectiveSidecar is the configuration of the sidecar proxies of a workload.
+type EffectiveSidecar struct {
+	// Sidecar is the Sidecar applying to the workload, or nil if none does
+	// and the workload imports every service visible in its namespace.
+	Sidecar *v1alpha3.Sidecar
+	// Services are the services imported by the workload, sorted by name.
+	Services []Service
+	// VirtualServices, DestinationRules and ServiceEntries are the objects
+	// imported by the workload, in the order of the snapshot.
+	VirtualServices  []*v1alpha3.VirtualService
+	DestinationRules []*v1alpha3.DestinationRule
+	ServiceEntries   []*v1alpha3.ServiceEntry
+}
+
+// SelectSidecar returns the Sidecar applying to the workload, or nil if none
+// does. Like Istio, it picks the oldest of the first non-empty group of
+//  1. the Sidecars in the namespace of the workload whose workload selector
+//     matches the labels of the workload,
+//  2. the Sidecars in the namespace of the workload without a workload
+//     selector,
+//  3. the Sidecars in the root namespace without a workload selector.
+func SelectSidecar(snapshot *Snapshot, workload Workload) *v1alpha3.Sidecar {
+	rootNamespace := snapshot.RootNamespace
+	if rootNamespace == "" {
+		rootNamespace = DefaultRootNamespace
+	}
+
+	var selected, namespaceDefault, rootDefault *v1alpha3.Sidecar
+	for i := range snapshot.Sidecars {
+		sidecar := &snapshot.Sidecars[i]
+		selector := sidecar.Spec.WorkloadSelector
+		hasSelector := selector != nil && len(selector.Labels) > 0
+		switch {
+		case sidecar.Namespace == workload.Namespace && hasSelector:
+			if labels.SelectorFromSet(selector.Labels).Matches(labels.Set(workload.Labels)) {
+				selected = oldestSidecar(selected, sidecar)
+			}
+		case sidecar.Namespace == workload.Namespace:
+			namespaceDefault = oldestSidecar(namespaceDefault, sidecar)
+		case sidecar.Namespace == rootNamespace && !hasSelector:
+			rootDefault = oldestSidecar(rootDefault, sidecar)
+		}
+	}
+
+	switch {
+	case selected != nil:
+		return selected
+	case namespaceDefault != nil:
+		return namespaceDefault
+	default:
+		return rootDefault
+	}
+}
+
+// ResolveSidecar returns the configuration of the sidecar proxies of the
+// workload: the Sidecar applying to it, and the services and objects visible
+// in its namespace which the egress hosts of the Sidecar import.
+//
+// Virtual services are imported if any of their hosts is, unless they are
+// bound to gateways only. Destination rules are imported if their host
+// matches an imported service.
+func ResolveSidecar(snapshot *Snapshot, workload Workload) *EffectiveSidecar {
+	effective := &EffectiveSidecar{
+		Sidecar: SelectSidecar(snapshot, workload),
+	}
+	egressHosts := sidecarEgressHosts(effective.Sidecar, workload.Namespace)
+	imports := func(namespace string, name host.Name) bool {
+		for _, h := range egressHosts {
+			if h.InNamespace(namespace) && h.Name.Intersects(name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	services := map[Service]bool{}
+	for i := range snapshot.Services {
+		svc := &snapshot.Services[i]
+		name := host.NewName(svc.Name).FQDN(svc.Namespace, snapshot.ClusterDomain)
+		if exportedTo(serviceExportTo(svc), svc.Namespace, workload.Namespace) && imports(svc.Namespace, name) {
+			services[Service{svc.Namespace, name}] = true
+		}
+	}
+	for i := range snapshot.ServiceEntries {
+		se := &snapshot.ServiceEntries[i]
+		if !exportedTo(se.Spec.ExportTo, se.Namespace, workload.Namespace) {
+			continue
+		}
+		imported := false
+		for _, h := range se.Spec.Hosts {
+			name := host.NewName(h).FQDN(se.Namespace, snapshot.ClusterDomain)
+			if imports(se.Namespace, name) {
+				services[Service{se.Namespace, name}] = true
+				imported = true
+			}
+		}
+		if imported {
+			effective.ServiceEntries = append(effective.ServiceEntries, se)
+		}
+	}
+	for svc := range services {
+		effective.Services = append(effective.Services, svc)
+	}
+	sort.Slice(effective.Services, func(i, j int) bool {
+		x, y := effective.Services[i], effective.Services[j]
+		if x.Name != y.Name {
+			return x.Name < y.Name
+		}
+		return x.Namespace < y.Namespace
+	})
+
+	for i := range snapshot.VirtualServices {
+		vs := &snapshot.VirtualServices[i]
+		if !exportedTo(vs.Spec.ExportTo, vs.Namespace, workload.Namespace) || !boundToMesh(vs) {
+			continue
+		}
+		for _, h := range vs.Spec.Hosts {
+			if imports(vs.Namespace, host.NewName(h).FQDN(vs.Namespace, snapshot.ClusterDomain)) {
+				effective.VirtualServices = append(effective.VirtualServices, vs)
+				break
+			}
+		}
+	}
+	for i := range snapshot.DestinationRules {
+		dr := &snapshot.DestinationRules[i]
+		if !exportedTo(dr.Spec.ExportTo, dr.Namespace, workload.Namespace) {
+			continue
+		}
+		name := host.NewName(dr.Spec.Host).FQDN(dr.Namespace, snapshot.ClusterDomain)
+		for _, svc := range effective.Services {
+			if name.Intersects(svc.Name) {
+				effective.DestinationRules = append(effective.DestinationRules, dr)
+				break
+			}
+		}
+	}
+
+	return effective
+}
+
+// sidecarEgressHosts returns the hosts imported by the Sidecar for a workload
+// of the namespace. Like Istio, "." refers to the namespace of the workload,
+// which differs from that of the Sidecar for root namespace defaults. Without
+// a Sidecar or egress listeners every host is imported.
+func sidecarEgressHosts(sidecar *v1alpha3.Sidecar, namespace string) []host.Host {
+	var hosts []host.Host
+	if sidecar != nil {
+		for _, listener := range sidecar.Spec.Egress {
+			if listener == nil {
+				continue
+			}
+			for _, egressHost := range listener.Hosts {
+				if h, err := host.Parse(egressHost); err == nil {
+					hosts = append(hosts, h.Resolve(namespace))
+				}
+			}
+		}
+	}
+	if sidecar == nil || len(sidecar.Spec.Egress) == 0 {
+		hosts = []host.Host{{Namespace: host.AnyNamespace, Name: "*"}}
+	}
+
+	return hosts
+}
+
+// exportedTo reports whether an object of the namespace exported to the
+// exportTo namespaces is visible in the target namespace. Objects without
+// exportTo are visible in every namespace.
+func exportedTo(exportTo []string, namespace, target string) bool {
+	if len(exportTo) == 0 {
+		return true
+	}
+	for _, e := range exportTo {
+		if e == "*" || (e == "." && namespace == target) || e == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func serviceExportTo(svc *corev1.Service) []string {
+	annotation, ok := svc.Annotations[ExportToAnnotation]
+	if !ok {
+		return nil
+	}
+	var exportTo []string
+	for _, e := range strings.Split(annotation, ",") {
+		exportTo = append(exportTo, strings.TrimSpace(e))
+	}
+
+	return exportTo
+}
+
+func boundToMesh(vs *v1alpha3.VirtualService) bool {
+	if len(vs.Spec.Gateways) == 0 {
+		return true
+	}
+	for _, gateway := range vs.Spec.Gateways {
+		if gateway == MeshGateway {
+			return true
+		}
+	}
+
+	return false
+}
+
+func oldestSidecar(current, candidate *v1alpha3.Sidecar) *v1alpha3.Sidecar {
+	if current == nil || older(&candidate.ObjectMeta, &current.ObjectMeta) {
+		return candidate
+	}
+
+	return current
+}
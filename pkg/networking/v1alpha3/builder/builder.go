@@ -0,0 +1,51 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package builder provides chainable constructors for networking resources,
+// sparing callers from setting pointer fields by hand. Every Build method
+// validates the resource it returns.
+package builder
+
+import (
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func destination(host string, port uint32) *v1alpha3.Destination {
+	destination := &v1alpha3.Destination{Host: host}
+	if port != 0 {
+		destination.Port = &v1alpha3.PortSelector{Number: port}
+	}
+
+	return destination
+}
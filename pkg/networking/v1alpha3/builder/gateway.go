@@ -0,0 +1,143 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3/validation"
+)
+
+// GatewayBuilder builds a Gateway.
+type GatewayBuilder struct {
+	gw v1alpha3.Gateway
+}
+
+// NewGateway starts building a Gateway.
+func NewGateway(namespace, name string) *GatewayBuilder {
+	return &GatewayBuilder{
+		gw: v1alpha3.Gateway{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha3.SchemeGroupVersion.String(),
+				Kind:       "Gateway",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Labels sets the labels of the Gateway.
+func (b *GatewayBuilder) Labels(labels map[string]string) *GatewayBuilder {
+	b.gw.Labels = labels
+	return b
+}
+
+// Selector sets the labels of the gateway pods the configuration applies to.
+func (b *GatewayBuilder) Selector(selector map[string]string) *GatewayBuilder {
+	b.gw.Spec.Selector = selector
+	return b
+}
+
+// Servers adds servers to the Gateway.
+func (b *GatewayBuilder) Servers(servers ...*ServerBuilder) *GatewayBuilder {
+	for _, server := range servers {
+		b.gw.Spec.Servers = append(b.gw.Spec.Servers, *server.server.DeepCopy())
+	}
+	return b
+}
+
+// Build validates and returns the Gateway.
+func (b *GatewayBuilder) Build() (*v1alpha3.Gateway, error) {
+	gw := b.gw.DeepCopy()
+	if errs := validation.ValidateGateway(gw); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return gw, nil
+}
+
+// ServerBuilder builds a Server.
+type ServerBuilder struct {
+	server v1alpha3.Server
+}
+
+// Server starts building a server listening on the port.
+func Server(number int, name string, protocol v1alpha3.PortProtocol) *ServerBuilder {
+	return &ServerBuilder{
+		server: v1alpha3.Server{
+			Port: &v1alpha3.Port{
+				Number:   number,
+				Name:     name,
+				Protocol: protocol,
+			},
+		},
+	}
+}
+
+// Hosts adds hosts exposed by the server, in the namespace/host form.
+func (b *ServerBuilder) Hosts(hosts ...string) *ServerBuilder {
+	b.server.Hosts = append(b.server.Hosts, hosts...)
+	return b
+}
+
+// HTTPSRedirect makes the server redirect HTTP requests to HTTPS.
+func (b *ServerBuilder) HTTPSRedirect() *ServerBuilder {
+	b.tls().HTTPSRedirect = boolPtr(true)
+	return b
+}
+
+// SimpleTLS terminates TLS with the certificate stored in the secret named
+// credentialName.
+func (b *ServerBuilder) SimpleTLS(credentialName string) *ServerBuilder {
+	tls := b.tls()
+	tls.Mode = v1alpha3.TLSModeSimple
+	tls.CredentialName = stringPtr(credentialName)
+	return b
+}
+
+// MutualTLS terminates TLS and verifies client certificates with the
+// certificate and CA bundle stored in the secret named credentialName.
+func (b *ServerBuilder) MutualTLS(credentialName string) *ServerBuilder {
+	tls := b.tls()
+	tls.Mode = v1alpha3.TLSModeMutual
+	tls.CredentialName = stringPtr(credentialName)
+	return b
+}
+
+// PassThroughTLS forwards TLS connections based on their SNI.
+func (b *ServerBuilder) PassThroughTLS() *ServerBuilder {
+	b.tls().Mode = v1alpha3.TLSModePassThrough
+	return b
+}
+
+// TLSVersions limits the TLS protocol versions accepted by the server.
+func (b *ServerBuilder) TLSVersions(minVersion, maxVersion v1alpha3.TLSProtocol) *ServerBuilder {
+	tls := b.tls()
+	tls.MinProtocolVersion = &minVersion
+	tls.MaxProtocolVersion = &maxVersion
+	return b
+}
+
+func (b *ServerBuilder) tls() *v1alpha3.TLSOptions {
+	if b.server.TLS == nil {
+		b.server.TLS = &v1alpha3.TLSOptions{}
+	}
+
+	return b.server.TLS
+}
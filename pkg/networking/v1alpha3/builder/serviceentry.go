@@ -0,0 +1,161 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3/validation"
+)
+
+// ServiceEntryBuilder builds a ServiceEntry.
+type ServiceEntryBuilder struct {
+	se v1alpha3.ServiceEntry
+}
+
+// NewServiceEntry starts building a ServiceEntry.
+func NewServiceEntry(namespace, name string) *ServiceEntryBuilder {
+	return &ServiceEntryBuilder{
+		se: v1alpha3.ServiceEntry{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha3.SchemeGroupVersion.String(),
+				Kind:       "ServiceEntry",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Labels sets the labels of the ServiceEntry.
+func (b *ServiceEntryBuilder) Labels(labels map[string]string) *ServiceEntryBuilder {
+	b.se.Labels = labels
+	return b
+}
+
+// Hosts adds hosts of the service.
+func (b *ServiceEntryBuilder) Hosts(hosts ...string) *ServiceEntryBuilder {
+	b.se.Spec.Hosts = append(b.se.Spec.Hosts, hosts...)
+	return b
+}
+
+// Addresses adds virtual IP addresses or CIDR blocks of the service.
+func (b *ServiceEntryBuilder) Addresses(addresses ...string) *ServiceEntryBuilder {
+	b.se.Spec.Addresses = append(b.se.Spec.Addresses, addresses...)
+	return b
+}
+
+// Port adds a port of the service.
+func (b *ServiceEntryBuilder) Port(number int, name string, protocol v1alpha3.PortProtocol) *ServiceEntryBuilder {
+	b.se.Spec.Ports = append(b.se.Spec.Ports, &v1alpha3.Port{
+		Number:   number,
+		Name:     name,
+		Protocol: protocol,
+	})
+	return b
+}
+
+// Location sets whether the service is part of the mesh.
+func (b *ServiceEntryBuilder) Location(location v1alpha3.ServiceEntryLocation) *ServiceEntryBuilder {
+	b.se.Spec.Location = &location
+	return b
+}
+
+// Resolution sets how the endpoints of the service are discovered.
+func (b *ServiceEntryBuilder) Resolution(resolution v1alpha3.ServiceEntryResolution) *ServiceEntryBuilder {
+	b.se.Spec.Resolution = &resolution
+	return b
+}
+
+// Endpoints adds endpoints of the service.
+func (b *ServiceEntryBuilder) Endpoints(endpoints ...*EndpointBuilder) *ServiceEntryBuilder {
+	for _, endpoint := range endpoints {
+		b.se.Spec.Endpoints = append(b.se.Spec.Endpoints, endpoint.endpoint.DeepCopy())
+	}
+	return b
+}
+
+// ExportTo adds namespaces the ServiceEntry is exported to.
+func (b *ServiceEntryBuilder) ExportTo(namespaces ...string) *ServiceEntryBuilder {
+	b.se.Spec.ExportTo = append(b.se.Spec.ExportTo, namespaces...)
+	return b
+}
+
+// SubjectAltNames adds the identities the workloads of the service may
+// present.
+func (b *ServiceEntryBuilder) SubjectAltNames(names ...string) *ServiceEntryBuilder {
+	b.se.Spec.SubjectAltNames = append(b.se.Spec.SubjectAltNames, names...)
+	return b
+}
+
+// Build validates and returns the ServiceEntry.
+func (b *ServiceEntryBuilder) Build() (*v1alpha3.ServiceEntry, error) {
+	se := b.se.DeepCopy()
+	if errs := validation.ValidateServiceEntry(se); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return se, nil
+}
+
+// EndpointBuilder builds a ServiceEntryEndpoint.
+type EndpointBuilder struct {
+	endpoint v1alpha3.ServiceEntryEndpoint
+}
+
+// Endpoint starts building an endpoint with an IP address or a host name.
+func Endpoint(address string) *EndpointBuilder {
+	return &EndpointBuilder{
+		endpoint: v1alpha3.ServiceEntryEndpoint{
+			Address: stringPtr(address),
+		},
+	}
+}
+
+// Port maps the named port of the service to a port of the endpoint.
+func (b *EndpointBuilder) Port(name string, number uint32) *EndpointBuilder {
+	if b.endpoint.Ports == nil {
+		b.endpoint.Ports = map[string]uint32{}
+	}
+	b.endpoint.Ports[name] = number
+	return b
+}
+
+// Labels sets the labels of the endpoint.
+func (b *EndpointBuilder) Labels(labels map[string]string) *EndpointBuilder {
+	b.endpoint.Labels = labels
+	return b
+}
+
+// Network sets the network the endpoint is in.
+func (b *EndpointBuilder) Network(network string) *EndpointBuilder {
+	b.endpoint.Network = stringPtr(network)
+	return b
+}
+
+// Locality sets the region/zone/subzone of the endpoint.
+func (b *EndpointBuilder) Locality(locality string) *EndpointBuilder {
+	b.endpoint.Locality = stringPtr(locality)
+	return b
+}
+
+// Weight sets the load balancing weight of the endpoint.
+func (b *EndpointBuilder) Weight(weight uint32) *EndpointBuilder {
+	b.endpoint.Weight = uint32Ptr(weight)
+	return b
+}
@@ -0,0 +1,495 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3/validation"
+)
+
+// VirtualServiceBuilder builds a VirtualService.
+type VirtualServiceBuilder struct {
+	vs   v1alpha3.VirtualService
+	errs field.ErrorList
+}
+
+// NewVirtualService starts building a VirtualService.
+func NewVirtualService(namespace, name string) *VirtualServiceBuilder {
+	return &VirtualServiceBuilder{
+		vs: v1alpha3.VirtualService{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha3.SchemeGroupVersion.String(),
+				Kind:       "VirtualService",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Labels sets the labels of the VirtualService.
+func (b *VirtualServiceBuilder) Labels(labels map[string]string) *VirtualServiceBuilder {
+	b.vs.Labels = labels
+	return b
+}
+
+// Hosts adds destination hosts.
+func (b *VirtualServiceBuilder) Hosts(hosts ...string) *VirtualServiceBuilder {
+	b.vs.Spec.Hosts = append(b.vs.Spec.Hosts, hosts...)
+	return b
+}
+
+// Gateways adds the gateways the routes apply to.
+func (b *VirtualServiceBuilder) Gateways(gateways ...string) *VirtualServiceBuilder {
+	b.vs.Spec.Gateways = append(b.vs.Spec.Gateways, gateways...)
+	return b
+}
+
+// ExportTo adds namespaces the VirtualService is exported to.
+func (b *VirtualServiceBuilder) ExportTo(namespaces ...string) *VirtualServiceBuilder {
+	b.vs.Spec.ExportTo = append(b.vs.Spec.ExportTo, namespaces...)
+	return b
+}
+
+// HTTP adds HTTP routes, evaluated in the order they are added.
+func (b *VirtualServiceBuilder) HTTP(routes ...*HTTPRouteBuilder) *VirtualServiceBuilder {
+	for _, route := range routes {
+		b.errs = append(b.errs, routeErrors(field.NewPath("spec", "http").Index(len(b.vs.Spec.HTTP)), route.errs)...)
+		b.vs.Spec.HTTP = append(b.vs.Spec.HTTP, *route.route.DeepCopy())
+	}
+	return b
+}
+
+// TLS adds TLS routes for passthrough traffic.
+func (b *VirtualServiceBuilder) TLS(routes ...*TLSRouteBuilder) *VirtualServiceBuilder {
+	for _, route := range routes {
+		b.errs = append(b.errs, routeErrors(field.NewPath("spec", "tls").Index(len(b.vs.Spec.TLS)), route.errs)...)
+		b.vs.Spec.TLS = append(b.vs.Spec.TLS, *route.route.DeepCopy())
+	}
+	return b
+}
+
+// TCP adds TCP routes.
+func (b *VirtualServiceBuilder) TCP(routes ...*TCPRouteBuilder) *VirtualServiceBuilder {
+	for _, route := range routes {
+		b.errs = append(b.errs, routeErrors(field.NewPath("spec", "tcp").Index(len(b.vs.Spec.TCP)), route.errs)...)
+		b.vs.Spec.TCP = append(b.vs.Spec.TCP, *route.route.DeepCopy())
+	}
+	return b
+}
+
+// Build validates and returns the VirtualService.
+func (b *VirtualServiceBuilder) Build() (*v1alpha3.VirtualService, error) {
+	vs := b.vs.DeepCopy()
+	errs := append(field.ErrorList{}, b.errs...)
+	if errs = append(errs, validation.ValidateVirtualService(vs)...); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return vs, nil
+}
+
+// routeErrors reports the destination settings a route builder could not
+// apply because no destination had been added yet.
+func routeErrors(fldPath *field.Path, errs []string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, err := range errs {
+		allErrs = append(allErrs, field.Required(fldPath.Child("route"), err))
+	}
+
+	return allErrs
+}
+
+// HTTPRouteBuilder builds an HTTPRoute.
+type HTTPRouteBuilder struct {
+	route v1alpha3.HTTPRoute
+	errs  []string
+}
+
+// Route starts building an HTTP route.
+func Route() *HTTPRouteBuilder {
+	return &HTTPRouteBuilder{}
+}
+
+// Name sets the name of the route.
+func (b *HTTPRouteBuilder) Name(name string) *HTTPRouteBuilder {
+	b.route.Name = stringPtr(name)
+	return b
+}
+
+// Match adds match conditions, the route applies if any of them matches.
+func (b *HTTPRouteBuilder) Match(matches ...*HTTPMatchBuilder) *HTTPRouteBuilder {
+	for _, match := range matches {
+		b.route.Match = append(b.route.Match, match.match.DeepCopy())
+	}
+	return b
+}
+
+// MatchPrefix adds a match condition on the URI prefix.
+func (b *HTTPRouteBuilder) MatchPrefix(prefix string) *HTTPRouteBuilder {
+	return b.Match(Match().Prefix(prefix))
+}
+
+// MatchExact adds a match condition on the exact URI.
+func (b *HTTPRouteBuilder) MatchExact(uri string) *HTTPRouteBuilder {
+	return b.Match(Match().Exact(uri))
+}
+
+// MatchRegex adds a match condition on the URI with a regular expression.
+func (b *HTTPRouteBuilder) MatchRegex(regex string) *HTTPRouteBuilder {
+	return b.Match(Match().Regex(regex))
+}
+
+// To adds a destination. Port may be zero when the host exposes a single
+// port.
+func (b *HTTPRouteBuilder) To(host string, port uint32) *HTTPRouteBuilder {
+	b.route.Route = append(b.route.Route, &v1alpha3.HTTPRouteDestination{
+		Destination: destination(host, port),
+	})
+	return b
+}
+
+// Subset sets the subset of the last destination. Calling it before To is
+// reported by Build.
+func (b *HTTPRouteBuilder) Subset(subset string) *HTTPRouteBuilder {
+	if destination := b.lastDestination("subset"); destination != nil {
+		destination.Destination.Subset = stringPtr(subset)
+	}
+	return b
+}
+
+// Weight sets the weight of the last destination. Calling it before To is
+// reported by Build.
+func (b *HTTPRouteBuilder) Weight(weight int) *HTTPRouteBuilder {
+	if destination := b.lastDestination("weight"); destination != nil {
+		destination.Weight = intPtr(weight)
+	}
+	return b
+}
+
+func (b *HTTPRouteBuilder) lastDestination(setting string) *v1alpha3.HTTPRouteDestination {
+	if len(b.route.Route) == 0 {
+		b.errs = append(b.errs, noDestination(setting))
+		return nil
+	}
+
+	return b.route.Route[len(b.route.Route)-1]
+}
+
+func noDestination(setting string) string {
+	return "a destination has to be added before setting its " + setting
+}
+
+// Redirect redirects matching requests to the URI with the given code, the
+// default 301 is used when the code is zero.
+func (b *HTTPRouteBuilder) Redirect(uri string, code uint32) *HTTPRouteBuilder {
+	b.route.Redirect = &v1alpha3.HTTPRedirect{URI: stringPtr(uri)}
+	if code != 0 {
+		b.route.Redirect.RedirectCode = uint32Ptr(code)
+	}
+	return b
+}
+
+// RewriteURI rewrites the matched part of the URI before forwarding.
+func (b *HTTPRouteBuilder) RewriteURI(uri string) *HTTPRouteBuilder {
+	if b.route.Rewrite == nil {
+		b.route.Rewrite = &v1alpha3.HTTPRewrite{}
+	}
+	b.route.Rewrite.URI = stringPtr(uri)
+	return b
+}
+
+// RewriteAuthority rewrites the Authority/Host header before forwarding.
+func (b *HTTPRouteBuilder) RewriteAuthority(authority string) *HTTPRouteBuilder {
+	if b.route.Rewrite == nil {
+		b.route.Rewrite = &v1alpha3.HTTPRewrite{}
+	}
+	b.route.Rewrite.Authority = stringPtr(authority)
+	return b
+}
+
+// Timeout sets the timeout of requests.
+func (b *HTTPRouteBuilder) Timeout(timeout time.Duration) *HTTPRouteBuilder {
+	b.route.Timeout = v1alpha1.NewDuration(timeout)
+	return b
+}
+
+// Retries sets the number of retries and the timeout of each try.
+func (b *HTTPRouteBuilder) Retries(attempts int, perTryTimeout time.Duration) *HTTPRouteBuilder {
+	if b.route.Retries == nil {
+		b.route.Retries = &v1alpha3.HTTPRetry{}
+	}
+	b.route.Retries.Attempts = attempts
+	b.route.Retries.PerTryTimeout = v1alpha1.Duration{Duration: perTryTimeout}
+	return b
+}
+
+// RetryOn sets the conditions requests are retried on, e.g. "5xx,reset".
+func (b *HTTPRouteBuilder) RetryOn(retryOn string) *HTTPRouteBuilder {
+	if b.route.Retries == nil {
+		b.route.Retries = &v1alpha3.HTTPRetry{}
+	}
+	b.route.Retries.RetryOn = stringPtr(retryOn)
+	return b
+}
+
+// Delay injects a fixed delay into the given percentage of requests.
+func (b *HTTPRouteBuilder) Delay(delay time.Duration, percent float32) *HTTPRouteBuilder {
+	if b.route.Fault == nil {
+		b.route.Fault = &v1alpha3.HTTPFaultInjection{}
+	}
+	b.route.Fault.Delay = &v1alpha3.Delay{
+		FixedDelay: v1alpha1.Duration{Duration: delay},
+		Percentage: &v1alpha3.Percentage{Value: percent},
+	}
+	return b
+}
+
+// Abort aborts the given percentage of requests with the HTTP status.
+func (b *HTTPRouteBuilder) Abort(httpStatus int, percent float32) *HTTPRouteBuilder {
+	if b.route.Fault == nil {
+		b.route.Fault = &v1alpha3.HTTPFaultInjection{}
+	}
+	b.route.Fault.Abort = &v1alpha3.Abort{
+		HTTPStatus: httpStatus,
+		Percentage: &v1alpha3.Percentage{Value: percent},
+	}
+	return b
+}
+
+// Mirror mirrors requests to the destination.
+func (b *HTTPRouteBuilder) Mirror(host string, port uint32) *HTTPRouteBuilder {
+	b.route.Mirror = destination(host, port)
+	return b
+}
+
+// SetRequestHeader overwrites a request header before forwarding.
+func (b *HTTPRouteBuilder) SetRequestHeader(name, value string) *HTTPRouteBuilder {
+	operations := b.requestHeaders()
+	if operations.Set == nil {
+		operations.Set = map[string]string{}
+	}
+	operations.Set[name] = value
+	return b
+}
+
+// RemoveRequestHeader removes a request header before forwarding.
+func (b *HTTPRouteBuilder) RemoveRequestHeader(name string) *HTTPRouteBuilder {
+	operations := b.requestHeaders()
+	operations.Remove = append(operations.Remove, name)
+	return b
+}
+
+// SetResponseHeader overwrites a response header before returning it.
+func (b *HTTPRouteBuilder) SetResponseHeader(name, value string) *HTTPRouteBuilder {
+	operations := b.responseHeaders()
+	if operations.Set == nil {
+		operations.Set = map[string]string{}
+	}
+	operations.Set[name] = value
+	return b
+}
+
+// RemoveResponseHeader removes a response header before returning it.
+func (b *HTTPRouteBuilder) RemoveResponseHeader(name string) *HTTPRouteBuilder {
+	operations := b.responseHeaders()
+	operations.Remove = append(operations.Remove, name)
+	return b
+}
+
+func (b *HTTPRouteBuilder) requestHeaders() *v1alpha3.HeaderOperations {
+	if b.route.Headers == nil {
+		b.route.Headers = &v1alpha3.Headers{}
+	}
+	if b.route.Headers.Request == nil {
+		b.route.Headers.Request = &v1alpha3.HeaderOperations{}
+	}
+
+	return b.route.Headers.Request
+}
+
+func (b *HTTPRouteBuilder) responseHeaders() *v1alpha3.HeaderOperations {
+	if b.route.Headers == nil {
+		b.route.Headers = &v1alpha3.Headers{}
+	}
+	if b.route.Headers.Response == nil {
+		b.route.Headers.Response = &v1alpha3.HeaderOperations{}
+	}
+
+	return b.route.Headers.Response
+}
+
+// HTTPMatchBuilder builds an HTTPMatchRequest. All conditions of a match have
+// to hold for it to match.
+type HTTPMatchBuilder struct {
+	match v1alpha3.HTTPMatchRequest
+}
+
+// Match starts building a match condition.
+func Match() *HTTPMatchBuilder {
+	return &HTTPMatchBuilder{}
+}
+
+// Name sets the name of the match.
+func (b *HTTPMatchBuilder) Name(name string) *HTTPMatchBuilder {
+	b.match.Name = stringPtr(name)
+	return b
+}
+
+// Prefix matches the URI prefix.
+func (b *HTTPMatchBuilder) Prefix(prefix string) *HTTPMatchBuilder {
+	b.match.URI = &v1alpha1.StringMatch{Prefix: prefix}
+	return b
+}
+
+// Exact matches the exact URI.
+func (b *HTTPMatchBuilder) Exact(uri string) *HTTPMatchBuilder {
+	b.match.URI = &v1alpha1.StringMatch{Exact: uri}
+	return b
+}
+
+// Regex matches the URI with a regular expression.
+func (b *HTTPMatchBuilder) Regex(regex string) *HTTPMatchBuilder {
+	b.match.URI = &v1alpha1.StringMatch{Regex: regex}
+	return b
+}
+
+// IgnoreURICase makes the URI match case insensitive.
+func (b *HTTPMatchBuilder) IgnoreURICase() *HTTPMatchBuilder {
+	b.match.IgnoreURICase = boolPtr(true)
+	return b
+}
+
+// Method matches the exact HTTP method.
+func (b *HTTPMatchBuilder) Method(method string) *HTTPMatchBuilder {
+	b.match.Method = &v1alpha1.StringMatch{Exact: method}
+	return b
+}
+
+// Authority matches the exact Authority/Host header.
+func (b *HTTPMatchBuilder) Authority(authority string) *HTTPMatchBuilder {
+	b.match.Authority = &v1alpha1.StringMatch{Exact: authority}
+	return b
+}
+
+// Header matches the exact value of a request header.
+func (b *HTTPMatchBuilder) Header(name, value string) *HTTPMatchBuilder {
+	if b.match.Headers == nil {
+		b.match.Headers = map[string]v1alpha1.StringMatch{}
+	}
+	b.match.Headers[name] = v1alpha1.StringMatch{Exact: value}
+	return b
+}
+
+// QueryParam matches the exact value of a query parameter.
+func (b *HTTPMatchBuilder) QueryParam(name, value string) *HTTPMatchBuilder {
+	if b.match.QueryParams == nil {
+		b.match.QueryParams = map[string]*v1alpha1.StringMatch{}
+	}
+	b.match.QueryParams[name] = &v1alpha1.StringMatch{Exact: value}
+	return b
+}
+
+// Port matches the port the request is received on.
+func (b *HTTPMatchBuilder) Port(port uint32) *HTTPMatchBuilder {
+	b.match.Port = uint32Ptr(port)
+	return b
+}
+
+// SourceLabels matches the labels of the workload sending the request.
+func (b *HTTPMatchBuilder) SourceLabels(labels map[string]string) *HTTPMatchBuilder {
+	b.match.SourceLabels = labels
+	return b
+}
+
+// TLSRouteBuilder builds a TLSRoute.
+type TLSRouteBuilder struct {
+	route v1alpha3.TLSRoute
+	errs  []string
+}
+
+// TLSRoute starts building a TLS route.
+func TLSRoute() *TLSRouteBuilder {
+	return &TLSRouteBuilder{}
+}
+
+// MatchSNI adds a match condition on the SNI hosts of the connection.
+func (b *TLSRouteBuilder) MatchSNI(hosts ...string) *TLSRouteBuilder {
+	b.route.Match = append(b.route.Match, v1alpha3.TLSMatchAttributes{SniHosts: hosts})
+	return b
+}
+
+// To adds a destination.
+func (b *TLSRouteBuilder) To(host string, port uint32) *TLSRouteBuilder {
+	b.route.Route = append(b.route.Route, &v1alpha3.RouteDestination{
+		Destination: destination(host, port),
+	})
+	return b
+}
+
+// Weight sets the weight of the last destination. Calling it before To is
+// reported by Build.
+func (b *TLSRouteBuilder) Weight(weight int) *TLSRouteBuilder {
+	if len(b.route.Route) == 0 {
+		b.errs = append(b.errs, noDestination("weight"))
+		return b
+	}
+	b.route.Route[len(b.route.Route)-1].Weight = intPtr(weight)
+	return b
+}
+
+// TCPRouteBuilder builds a TCPRoute.
+type TCPRouteBuilder struct {
+	route v1alpha3.TCPRoute
+	errs  []string
+}
+
+// TCPRoute starts building a TCP route.
+func TCPRoute() *TCPRouteBuilder {
+	return &TCPRouteBuilder{}
+}
+
+// MatchPort adds a match condition on the port of the connection.
+func (b *TCPRouteBuilder) MatchPort(port int) *TCPRouteBuilder {
+	b.route.Match = append(b.route.Match, v1alpha3.L4MatchAttributes{Port: intPtr(port)})
+	return b
+}
+
+// To adds a destination.
+func (b *TCPRouteBuilder) To(host string, port uint32) *TCPRouteBuilder {
+	b.route.Route = append(b.route.Route, &v1alpha3.RouteDestination{
+		Destination: destination(host, port),
+	})
+	return b
+}
+
+// Weight sets the weight of the last destination. Calling it before To is
+// reported by Build.
+func (b *TCPRouteBuilder) Weight(weight int) *TCPRouteBuilder {
+	if len(b.route.Route) == 0 {
+		b.errs = append(b.errs, noDestination("weight"))
+		return b
+	}
+	b.route.Route[len(b.route.Route)-1].Weight = intPtr(weight)
+	return b
+}
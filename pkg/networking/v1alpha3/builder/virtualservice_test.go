@@ -0,0 +1,85 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVirtualServiceDestinationSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		vs   *VirtualServiceBuilder
+		err  string
+	}{
+		{
+			name: "http subset and weight",
+			vs: NewVirtualService("default", "reviews").Hosts("reviews").HTTP(
+				Route().To("reviews", 0).Subset("v1").Weight(90).To("reviews", 0).Subset("v2").Weight(10),
+			),
+		},
+		{
+			name: "http subset before a destination",
+			vs:   NewVirtualService("default", "reviews").Hosts("reviews").HTTP(Route().Subset("v1").To("reviews", 0)),
+			err:  "spec.http[0].route: Required value: a destination has to be added before setting its subset",
+		},
+		{
+			name: "http weight before a destination",
+			vs: NewVirtualService("default", "reviews").Hosts("reviews").HTTP(
+				Route().To("reviews", 0),
+				Route().Weight(100).To("reviews", 0),
+			),
+			err: "spec.http[1].route: Required value: a destination has to be added before setting its weight",
+		},
+		{
+			name: "tls weight",
+			vs: NewVirtualService("default", "reviews").Hosts("reviews.example.com").TLS(
+				TLSRoute().MatchSNI("reviews.example.com").To("reviews", 443).Weight(100),
+			),
+		},
+		{
+			name: "tls weight before a destination",
+			vs: NewVirtualService("default", "reviews").Hosts("reviews.example.com").TLS(
+				TLSRoute().MatchSNI("reviews.example.com").Weight(100).To("reviews", 443),
+			),
+			err: "spec.tls[0].route: Required value: a destination has to be added before setting its weight",
+		},
+		{
+			name: "tcp weight",
+			vs:   NewVirtualService("default", "mongo").Hosts("mongo").TCP(TCPRoute().To("mongo", 27017).Weight(100)),
+		},
+		{
+			name: "tcp weight before a destination",
+			vs:   NewVirtualService("default", "mongo").Hosts("mongo").TCP(TCPRoute().Weight(100).To("mongo", 27017)),
+			err:  "spec.tcp[0].route: Required value: a destination has to be added before setting its weight",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.vs.Build()
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ErrUnconvertible is returned by ConvertToVersion for EnvoyFilter resources,
+// which have no v1beta1 form.
+var ErrUnconvertible = errors.New("EnvoyFilter exists in networking.istio.io/v1alpha3 only")
+
+// ConvertToVersion converts the object with the scheme like
+// runtime.Scheme.ConvertToVersion does, except that converting EnvoyFilter
+// and EnvoyFilterList to another version fails with an error wrapping
+// ErrUnconvertible instead of a not registered error.
+func ConvertToVersion(s *runtime.Scheme, in runtime.Object, target runtime.GroupVersioner) (runtime.Object, error) {
+	switch in.(type) {
+	case *EnvoyFilter, *EnvoyFilterList:
+		kinds, _, err := s.ObjectKinds(in)
+		if err != nil {
+			return nil, err
+		}
+		if gvk, ok := target.KindForGroupVersionKinds(kinds); !ok || gvk.GroupVersion() != SchemeGroupVersion {
+			return nil, fmt.Errorf("converting %s to %s: %w", kinds[0].Kind, target, ErrUnconvertible)
+		}
+	}
+
+	return s.ConvertToVersion(in, target)
+}
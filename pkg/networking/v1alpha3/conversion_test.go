@@ -0,0 +1,119 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3_test
+
+import (
+	"errors"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/randfill"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1alpha3.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return scheme
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	filler := randfill.NewWithSeed(1).NilChance(0.2).NumElements(1, 3)
+
+	kinds := []runtime.Object{
+		&v1alpha3.VirtualService{},
+		&v1alpha3.DestinationRule{},
+		&v1alpha3.Gateway{},
+		&v1alpha3.ServiceEntry{},
+		&v1alpha3.Sidecar{},
+		&v1alpha3.WorkloadEntry{},
+	}
+	for _, kind := range kinds {
+		gvks, _, err := scheme.ObjectKinds(kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gvk := gvks[0]
+
+		t.Run(gvk.Kind, func(t *testing.T) {
+			for i := 0; i < 50; i++ {
+				original := kind.DeepCopyObject()
+				filler.Fill(original)
+				original.GetObjectKind().SetGroupVersionKind(gvk)
+
+				converted, err := scheme.ConvertToVersion(original.DeepCopyObject(), v1beta1.SchemeGroupVersion)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if converted.GetObjectKind().GroupVersionKind() != v1beta1.SchemeGroupVersion.WithKind(gvk.Kind) {
+					t.Fatalf("converted to %v", converted.GetObjectKind().GroupVersionKind())
+				}
+				roundTripped, err := scheme.ConvertToVersion(converted, v1alpha3.SchemeGroupVersion)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !equality.Semantic.DeepEqual(original, roundTripped) {
+					t.Fatalf("round trip is lossy:\n%#v\n%#v", original, roundTripped)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertToVersion(t *testing.T) {
+	scheme := newScheme(t)
+
+	tests := []struct {
+		name   string
+		obj    runtime.Object
+		target runtime.GroupVersioner
+		err    error
+	}{
+		{name: "EnvoyFilter to v1beta1", obj: &v1alpha3.EnvoyFilter{}, target: v1beta1.SchemeGroupVersion, err: v1alpha3.ErrUnconvertible},
+		{name: "EnvoyFilterList to v1beta1", obj: &v1alpha3.EnvoyFilterList{}, target: v1beta1.SchemeGroupVersion, err: v1alpha3.ErrUnconvertible},
+		{name: "EnvoyFilter to v1alpha3", obj: &v1alpha3.EnvoyFilter{}, target: v1alpha3.SchemeGroupVersion},
+		{name: "VirtualService to v1beta1", obj: &v1alpha3.VirtualService{}, target: v1beta1.SchemeGroupVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := v1alpha3.ConvertToVersion(scheme, tt.obj, tt.target)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+			if err != nil {
+				return
+			}
+			gvks, _, err := scheme.ObjectKinds(converted)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gv := tt.target.(schema.GroupVersion); gvks[0].GroupVersion() != gv {
+				t.Errorf("expected a %s object, got %s", gv, gvks[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+// Defaults applied by the Istio control plane when the fields are not set.
+const (
+	DefaultRetryAttempts             = 2
+	DefaultRetryOn                   = "connect-failure,refused-stream,unavailable,cancelled,retriable-status-codes"
+	DefaultRedirectCode              = 301
+	DefaultHTTP1MaxPendingRequests   = 1024
+	DefaultHTTP2MaxRequests          = 1024
+	DefaultMaxRetries                = 3
+	DefaultOutlierConsecutiveErrors  = 5
+	DefaultOutlierInterval           = 10 * time.Second
+	DefaultOutlierBaseEjectionTime   = 30 * time.Second
+	DefaultOutlierMaxEjectionPercent = 10
+	DefaultExportTo                  = "*"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_VirtualServiceSpec(obj *VirtualServiceSpec) {
+	if len(obj.ExportTo) == 0 {
+		obj.ExportTo = []string{DefaultExportTo}
+	}
+}
+
+func SetDefaults_HTTPRoute(obj *HTTPRoute) {
+	// retries are only applied to forwarded requests
+	if obj.Retries == nil && len(obj.Route) > 0 {
+		obj.Retries = &HTTPRetry{
+			Attempts: DefaultRetryAttempts,
+		}
+	}
+}
+
+func SetDefaults_HTTPRetry(obj *HTTPRetry) {
+	if obj.RetryOn == nil {
+		retryOn := DefaultRetryOn
+		obj.RetryOn = &retryOn
+	}
+}
+
+func SetDefaults_HTTPRedirect(obj *HTTPRedirect) {
+	if obj.RedirectCode == nil {
+		code := uint32(DefaultRedirectCode)
+		obj.RedirectCode = &code
+	}
+}
+
+func SetDefaults_DestinationRuleSpec(obj *DestinationRuleSpec) {
+	if len(obj.ExportTo) == 0 {
+		obj.ExportTo = []string{DefaultExportTo}
+	}
+}
+
+func SetDefaults_LoadBalancerSettings(obj *LoadBalancerSettings) {
+	if obj.Simple == nil && obj.ConsistentHash == nil {
+		simple := SimpleLBRoundRobin
+		obj.Simple = &simple
+	}
+}
+
+func SetDefaults_HTTPSettings(obj *HTTPSettings) {
+	if obj.HTTP1MaxPendingRequests == nil {
+		requests := int32(DefaultHTTP1MaxPendingRequests)
+		obj.HTTP1MaxPendingRequests = &requests
+	}
+	if obj.HTTP2MaxRequests == nil {
+		requests := int32(DefaultHTTP2MaxRequests)
+		obj.HTTP2MaxRequests = &requests
+	}
+	if obj.MaxRetries == nil {
+		retries := int32(DefaultMaxRetries)
+		obj.MaxRetries = &retries
+	}
+}
+
+func SetDefaults_OutlierDetection(obj *OutlierDetection) {
+	if obj.ConsecutiveErrors == 0 {
+		obj.ConsecutiveErrors = DefaultOutlierConsecutiveErrors
+	}
+	if obj.Interval == nil {
+		obj.Interval = v1alpha1.NewDuration(DefaultOutlierInterval)
+	}
+	if obj.BaseEjectionTime == nil {
+		obj.BaseEjectionTime = v1alpha1.NewDuration(DefaultOutlierBaseEjectionTime)
+	}
+	if obj.MaxEjectionPercent == nil {
+		percent := int32(DefaultOutlierMaxEjectionPercent)
+		obj.MaxEjectionPercent = &percent
+	}
+}
+
+func SetDefaults_TLSSettings(obj *TLSSettings) {
+	if obj.Mode == "" {
+		obj.Mode = TLSmodeDisable
+	}
+}
+
+func SetDefaults_ServiceEntrySpec(obj *ServiceEntrySpec) {
+	if obj.Location == nil {
+		location := MeshExternal
+		obj.Location = &location
+	}
+	if obj.Resolution == nil {
+		resolution := NONE
+		obj.Resolution = &resolution
+	}
+	if len(obj.ExportTo) == 0 {
+		obj.ExportTo = []string{DefaultExportTo}
+	}
+}
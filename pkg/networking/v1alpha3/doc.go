@@ -13,6 +13,9 @@
 // limitations under the License.
 
 // +k8s:deepcopy-gen=package
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
+// +k8s:conversion-gen=github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1
 // +groupName=networking.istio.io
 
 package v1alpha3
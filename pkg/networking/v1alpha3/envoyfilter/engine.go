@@ -0,0 +1,474 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package envoyfilter applies the config patches of EnvoyFilter resources to
+// the JSON configuration of a proxy offline, the way Istio patches the
+// configuration it generates for the proxy.
+package envoyfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// Object is an Envoy configuration object decoded from JSON, with the field
+// names of the protobuf definitions, e.g. "filter_chains".
+type Object = map[string]interface{}
+
+// Config is the configuration of a proxy, e.g. as read from the config dump
+// of the proxy.
+type Config struct {
+	Listeners           []Object `json:"listeners,omitempty"`
+	RouteConfigurations []Object `json:"routeConfigurations,omitempty"`
+	Clusters            []Object `json:"clusters,omitempty"`
+}
+
+// Proxy describes the proxy the configuration belongs to.
+type Proxy struct {
+	// Gateway is set for gateway proxies, whose configuration is in the
+	// GATEWAY patch context. The configuration of sidecars is in the
+	// SIDECAR_INBOUND or SIDECAR_OUTBOUND context depending on its direction.
+	Gateway bool
+	// Version is the Istio version of the proxy which ProxyMatch.ProxyVersion
+	// is matched against, e.g. "1.6.0".
+	Version string
+	// Metadata is the node metadata of the proxy which
+	// ProxyMatch.Metadata is matched against.
+	Metadata map[string]string
+}
+
+// PatchResult tells what a patch did.
+type PatchResult struct {
+	// Index is the index of the patch in the list applied.
+	Index int
+	Patch *v1alpha3.EnvoyConfigObjectPatch
+	// Matches is the number of objects the patch was applied to. Objects
+	// added by the patch count once for each object they were added to.
+	Matches int
+}
+
+// Report tells what the patches applied did, in the order they were applied.
+type Report struct {
+	Patches []PatchResult
+}
+
+// Matched returns the results of the patches which were applied to any
+// object.
+func (r *Report) Matched() []PatchResult {
+	var results []PatchResult
+	for _, result := range r.Patches {
+		if result.Matches > 0 {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// Unmatched returns the results of the patches which were applied to no
+// object.
+func (r *Report) Unmatched() []PatchResult {
+	var results []PatchResult
+	for _, result := range r.Patches {
+		if result.Matches == 0 {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// supportedOperations are the operations Istio supports for each kind of
+// object.
+var supportedOperations = map[v1alpha3.ApplyTo][]v1alpha3.PatchOperation{
+	v1alpha3.ApplyToListener:           {v1alpha3.PatchOperationMerge, v1alpha3.PatchOperationAdd, v1alpha3.PatchOperationRemove},
+	v1alpha3.ApplyToFilterChain:        {v1alpha3.PatchOperationMerge, v1alpha3.PatchOperationAdd, v1alpha3.PatchOperationRemove},
+	v1alpha3.ApplyToNetworkFilter:      listOperations,
+	v1alpha3.ApplyToHTTPFilter:         listOperations,
+	v1alpha3.ApplyToRouteConfiguration: {v1alpha3.PatchOperationMerge},
+	v1alpha3.ApplyToVirtualHost:        {v1alpha3.PatchOperationMerge, v1alpha3.PatchOperationAdd, v1alpha3.PatchOperationRemove},
+	v1alpha3.ApplyToHTTPRoute:          listOperations,
+	v1alpha3.ApplyToCluster:            {v1alpha3.PatchOperationMerge, v1alpha3.PatchOperationAdd, v1alpha3.PatchOperationRemove},
+}
+
+var listOperations = []v1alpha3.PatchOperation{
+	v1alpha3.PatchOperationMerge,
+	v1alpha3.PatchOperationAdd,
+	v1alpha3.PatchOperationRemove,
+	v1alpha3.PatchOperationInsertBefore,
+	v1alpha3.PatchOperationInsertAfter,
+	v1alpha3.PatchOperationInsertFirst,
+}
+
+// Apply applies the patches in order to a copy of the configuration, and
+// returns the patched configuration along with a report of the objects each
+// patch was applied to. Patches which Istio would reject, e.g. for an
+// operation not supported on their kind of object, fail the whole
+// application.
+//
+// Objects are merged the way protobuf messages are: objects are merged
+// recursively, lists are appended to, and other values are replaced.
+func Apply(config *Config, proxy Proxy, patches []*v1alpha3.EnvoyConfigObjectPatch) (*Config, *Report, error) {
+	patched := copyConfig(config)
+
+	report := &Report{}
+	for i, patch := range patches {
+		if patch == nil {
+			continue
+		}
+		p, err := newPatcher(proxy, patch)
+		if err != nil {
+			return nil, nil, fmt.Errorf("patch %d: %w", i, err)
+		}
+		report.Patches = append(report.Patches, PatchResult{
+			Index:   i,
+			Patch:   patch,
+			Matches: p.apply(patched),
+		})
+	}
+
+	return patched, report, nil
+}
+
+// patcher applies a single patch.
+type patcher struct {
+	proxy     Proxy
+	applyTo   v1alpha3.ApplyTo
+	operation v1alpha3.PatchOperation
+	value     Object
+	match     *v1alpha3.EnvoyConfigObjectMatch
+	// proxyMatches is set if the patch applies to the proxy at all.
+	proxyMatches bool
+}
+
+func newPatcher(proxy Proxy, patch *v1alpha3.EnvoyConfigObjectPatch) (*patcher, error) {
+	if patch.Patch == nil {
+		return nil, errors.New("patch is required")
+	}
+	p := &patcher{
+		proxy:     proxy,
+		applyTo:   patch.ApplyTo,
+		operation: patch.Patch.Operation,
+		match:     patch.Match,
+	}
+	if p.match == nil {
+		p.match = &v1alpha3.EnvoyConfigObjectMatch{Context: v1alpha3.PatchContextAny}
+	}
+
+	operations, ok := supportedOperations[p.applyTo]
+	if !ok {
+		return nil, fmt.Errorf("unsupported applyTo %q", p.applyTo)
+	}
+	supported := false
+	for _, operation := range operations {
+		supported = supported || operation == p.operation
+	}
+	if !supported {
+		return nil, fmt.Errorf("operation %q is not supported for %s", p.operation, p.applyTo)
+	}
+
+	if p.operation != v1alpha3.PatchOperationRemove {
+		if len(patch.Patch.Value) == 0 {
+			return nil, errors.New("patch value is required")
+		}
+		if err := json.Unmarshal(patch.Patch.Value, &p.value); err != nil {
+			return nil, fmt.Errorf("invalid patch value: %w", err)
+		}
+	}
+
+	p.proxyMatches = true
+	if proxyMatch := p.match.Proxy; proxyMatch != nil {
+		if proxyMatch.ProxyVersion != "" {
+			version, err := regexp.Compile(proxyMatch.ProxyVersion)
+			if err != nil {
+				return nil, fmt.Errorf("invalid proxyVersion: %w", err)
+			}
+			p.proxyMatches = version.MatchString(proxy.Version)
+		}
+		for key, value := range proxyMatch.Metadata {
+			if actual, ok := proxy.Metadata[key]; !ok || actual != value {
+				p.proxyMatches = false
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// apply applies the patch to the configuration and returns the number of
+// objects it was applied to.
+func (p *patcher) apply(config *Config) int {
+	if !p.proxyMatches {
+		return 0
+	}
+
+	switch p.applyTo {
+	case v1alpha3.ApplyToListener:
+		if p.operation == v1alpha3.PatchOperationAdd {
+			if !p.addsToProxy() {
+				return 0
+			}
+			config.Listeners = append(config.Listeners, copyObject(p.value))
+			return 1
+		}
+		var matches int
+		config.Listeners, matches = p.patchObjects(config.Listeners, p.listenerMatches)
+		return matches
+	case v1alpha3.ApplyToFilterChain, v1alpha3.ApplyToNetworkFilter, v1alpha3.ApplyToHTTPFilter:
+		matches := 0
+		for _, listener := range config.Listeners {
+			if p.listenerMatches(listener) {
+				matches += p.patchFilterChains(listener)
+			}
+		}
+		return matches
+	case v1alpha3.ApplyToRouteConfiguration, v1alpha3.ApplyToVirtualHost, v1alpha3.ApplyToHTTPRoute:
+		matches := 0
+		for _, routeConfiguration := range config.RouteConfigurations {
+			if !p.routeConfigurationMatches(routeConfiguration) {
+				continue
+			}
+			if p.applyTo == v1alpha3.ApplyToRouteConfiguration {
+				merge(routeConfiguration, copyObject(p.value))
+				matches++
+				continue
+			}
+			matches += p.patchVirtualHosts(routeConfiguration)
+		}
+		return matches
+	case v1alpha3.ApplyToCluster:
+		if p.operation == v1alpha3.PatchOperationAdd {
+			if !p.addsToProxy() {
+				return 0
+			}
+			config.Clusters = append(config.Clusters, copyObject(p.value))
+			return 1
+		}
+		var matches int
+		config.Clusters, matches = p.patchObjects(config.Clusters, p.clusterMatches)
+		return matches
+	}
+
+	return 0
+}
+
+func (p *patcher) patchFilterChains(listener Object) int {
+	if p.applyTo == v1alpha3.ApplyToFilterChain {
+		chains, _ := listener["filter_chains"].([]interface{})
+		var matches int
+		listener["filter_chains"], matches = p.patchList(chains, func(chain Object) bool {
+			return p.filterChainMatches(listener, chain)
+		})
+		return matches
+	}
+
+	matches := 0
+	for _, chain := range objects(listener, "filter_chains") {
+		if !p.filterChainMatches(listener, chain) {
+			continue
+		}
+		if p.applyTo == v1alpha3.ApplyToNetworkFilter {
+			filters, _ := chain["filters"].([]interface{})
+			var n int
+			chain["filters"], n = p.patchList(filters, p.networkFilterMatches)
+			matches += n
+			continue
+		}
+		for _, filter := range objects(chain, "filters") {
+			if !p.httpConnectionManagerMatches(filter) {
+				continue
+			}
+			typedConfig, ok := filter["typed_config"].(Object)
+			if !ok {
+				continue
+			}
+			httpFilters, _ := typedConfig["http_filters"].([]interface{})
+			var n int
+			typedConfig["http_filters"], n = p.patchList(httpFilters, p.httpFilterMatches)
+			matches += n
+		}
+	}
+
+	return matches
+}
+
+func (p *patcher) patchVirtualHosts(routeConfiguration Object) int {
+	virtualHosts, _ := routeConfiguration["virtual_hosts"].([]interface{})
+	if p.applyTo == v1alpha3.ApplyToVirtualHost {
+		var matches int
+		routeConfiguration["virtual_hosts"], matches = p.patchList(virtualHosts, p.virtualHostMatches)
+		return matches
+	}
+
+	matches := 0
+	for _, virtualHost := range objects(routeConfiguration, "virtual_hosts") {
+		if !p.virtualHostMatches(virtualHost) {
+			continue
+		}
+		routes, _ := virtualHost["routes"].([]interface{})
+		var n int
+		virtualHost["routes"], n = p.patchList(routes, p.routeMatches)
+		matches += n
+	}
+
+	return matches
+}
+
+// patchObjects applies a MERGE or REMOVE operation to the top level objects
+// of the configuration.
+func (p *patcher) patchObjects(list []Object, match func(Object) bool) ([]Object, int) {
+	elements := make([]interface{}, 0, len(list))
+	for _, obj := range list {
+		elements = append(elements, obj)
+	}
+	patched, matches := p.patchList(elements, match)
+
+	list = list[:0]
+	for _, element := range patched {
+		list = append(list, element.(Object))
+	}
+
+	return list, matches
+}
+
+// patchList applies the operation of the patch to the list whose parent
+// matched. The elements of the list are matched for every operation but ADD
+// and INSERT_FIRST, while INSERT_BEFORE and INSERT_AFTER insert next to the
+// first matching element only.
+func (p *patcher) patchList(list []interface{}, match func(Object) bool) ([]interface{}, int) {
+	switch p.operation {
+	case v1alpha3.PatchOperationAdd:
+		return append(list, copyObject(p.value)), 1
+	case v1alpha3.PatchOperationInsertFirst:
+		return append([]interface{}{copyObject(p.value)}, list...), 1
+	case v1alpha3.PatchOperationInsertBefore, v1alpha3.PatchOperationInsertAfter:
+		for i, element := range list {
+			obj, ok := element.(Object)
+			if !ok || !match(obj) {
+				continue
+			}
+			if p.operation == v1alpha3.PatchOperationInsertAfter {
+				i++
+			}
+			list = append(list[:i], append([]interface{}{copyObject(p.value)}, list[i:]...)...)
+			return list, 1
+		}
+		return list, 0
+	case v1alpha3.PatchOperationRemove:
+		patched := list[:0]
+		removed := 0
+		for _, element := range list {
+			if obj, ok := element.(Object); ok && match(obj) {
+				removed++
+				continue
+			}
+			patched = append(patched, element)
+		}
+		return patched, removed
+	default:
+		matches := 0
+		for _, element := range list {
+			if obj, ok := element.(Object); ok && match(obj) {
+				merge(obj, copyObject(p.value))
+				matches++
+			}
+		}
+		return list, matches
+	}
+}
+
+// addsToProxy reports whether listeners or clusters added by the patch are
+// added to the proxy, depending on whether the context of the patch is that
+// of a gateway or of a sidecar.
+func (p *patcher) addsToProxy() bool {
+	switch p.match.Context {
+	case v1alpha3.PatchContextGateway:
+		return p.proxy.Gateway
+	case v1alpha3.PatchContextSidecarInbound, v1alpha3.PatchContextSidecarOutbound:
+		return !p.proxy.Gateway
+	default:
+		return true
+	}
+}
+
+// merge merges src into dst the way protobuf messages are merged.
+func merge(dst, src Object) {
+	for key, value := range src {
+		switch value := value.(type) {
+		case Object:
+			if existing, ok := dst[key].(Object); ok {
+				merge(existing, value)
+				continue
+			}
+		case []interface{}:
+			if existing, ok := dst[key].([]interface{}); ok {
+				dst[key] = append(existing, value...)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// copyObject deep copies an object decoded from JSON. Values other than
+// objects and lists are immutable and shared.
+func copyObject(obj Object) Object {
+	copied := make(Object, len(obj))
+	for key, value := range obj {
+		copied[key] = copyValue(value)
+	}
+
+	return copied
+}
+
+func copyValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case Object:
+		return copyObject(value)
+	case []interface{}:
+		copied := make([]interface{}, len(value))
+		for i, element := range value {
+			copied[i] = copyValue(element)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+func copyConfig(config *Config) *Config {
+	if config == nil {
+		return &Config{}
+	}
+	copyObjects := func(list []Object) []Object {
+		if list == nil {
+			return nil
+		}
+		copied := make([]Object, len(list))
+		for i, obj := range list {
+			copied[i] = copyObject(obj)
+		}
+		return copied
+	}
+
+	return &Config{
+		Listeners:           copyObjects(config.Listeners),
+		RouteConfigurations: copyObjects(config.RouteConfigurations),
+		Clusters:            copyObjects(config.Clusters),
+	}
+}
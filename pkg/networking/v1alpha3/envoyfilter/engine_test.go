@@ -0,0 +1,281 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoyfilter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+const sidecarConfig = `{
+	"listeners": [{
+		"name": "0.0.0.0_80",
+		"address": {"socket_address": {"address": "0.0.0.0", "port_value": 80}},
+		"filter_chains": [{
+			"filters": [{
+				"name": "envoy.filters.network.http_connection_manager",
+				"typed_config": {"http_filters": [{"name": "envoy.cors"}, {"name": "envoy.router"}]}
+			}]
+		}]
+	}, {
+		"name": "virtualInbound",
+		"address": {"socket_address": {"address": "0.0.0.0", "port_value": 15006}},
+		"traffic_direction": "INBOUND",
+		"filter_chains": [{
+			"filter_chain_match": {"destination_port": 8080},
+			"filters": [{
+				"name": "envoy.filters.network.http_connection_manager",
+				"typed_config": {"http_filters": [{"name": "envoy.router"}]}
+			}]
+		}]
+	}]
+}`
+
+func httpFilterNames(t *testing.T, config *Config, listenerName string) []string {
+	t.Helper()
+
+	names := []string{}
+	for _, listener := range config.Listeners {
+		if str(listener, "name") != listenerName {
+			continue
+		}
+		for _, chain := range objects(listener, "filter_chains") {
+			for _, filter := range objects(chain, "filters") {
+				typedConfig, _ := filter["typed_config"].(Object)
+				for _, httpFilter := range objects(typedConfig, "http_filters") {
+					names = append(names, str(httpFilter, "name"))
+				}
+			}
+		}
+		return names
+	}
+	t.Fatalf("no listener %s", listenerName)
+
+	return nil
+}
+
+func httpFilterPatch(operation v1alpha3.PatchOperation, match *v1alpha3.EnvoyConfigObjectMatch) *v1alpha3.EnvoyConfigObjectPatch {
+	patch := &v1alpha3.EnvoyConfigObjectPatch{
+		ApplyTo: v1alpha3.ApplyToHTTPFilter,
+		Match:   match,
+		Patch:   &v1alpha3.Patch{Operation: operation},
+	}
+	if operation != v1alpha3.PatchOperationRemove {
+		patch.Patch.Value = json.RawMessage(`{"name": "envoy.lua"}`)
+	}
+
+	return patch
+}
+
+func outboundMatch(subFilter string) *v1alpha3.EnvoyConfigObjectMatch {
+	return &v1alpha3.EnvoyConfigObjectMatch{
+		Context: v1alpha3.PatchContextSidecarOutbound,
+		Listener: &v1alpha3.ListenerMatch{
+			PortNumber: 80,
+			FilterChain: &v1alpha3.FilterChainMatch{
+				Filter: &v1alpha3.FilterMatch{
+					Name:      HTTPConnectionManager,
+					SubFilter: &v1alpha3.SubFilterMatch{Name: subFilter},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyHTTPFilterPatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		proxy    Proxy
+		patch    *v1alpha3.EnvoyConfigObjectPatch
+		matches  int
+		outbound []string
+		inbound  []string
+	}{
+		{
+			name:     "insert before",
+			patch:    httpFilterPatch(v1alpha3.PatchOperationInsertBefore, outboundMatch("envoy.router")),
+			matches:  1,
+			outbound: []string{"envoy.cors", "envoy.lua", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:     "insert after",
+			patch:    httpFilterPatch(v1alpha3.PatchOperationInsertAfter, outboundMatch("envoy.cors")),
+			matches:  1,
+			outbound: []string{"envoy.cors", "envoy.lua", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:     "insert after the last filter",
+			patch:    httpFilterPatch(v1alpha3.PatchOperationInsertAfter, outboundMatch("envoy.router")),
+			matches:  1,
+			outbound: []string{"envoy.cors", "envoy.router", "envoy.lua"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:     "insert before a missing filter",
+			patch:    httpFilterPatch(v1alpha3.PatchOperationInsertBefore, outboundMatch("envoy.fault")),
+			matches:  0,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:     "remove",
+			patch:    httpFilterPatch(v1alpha3.PatchOperationRemove, outboundMatch("envoy.cors")),
+			matches:  1,
+			outbound: []string{"envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name: "inbound context",
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Context: v1alpha3.PatchContextSidecarInbound,
+			}),
+			matches:  1,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.lua", "envoy.router"},
+		},
+		{
+			name: "any context",
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Context: v1alpha3.PatchContextAny,
+			}),
+			matches:  2,
+			outbound: []string{"envoy.lua", "envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.lua", "envoy.router"},
+		},
+		{
+			name: "gateway context on a sidecar",
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Context: v1alpha3.PatchContextGateway,
+			}),
+			matches:  0,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name: "inbound destination port",
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Context:  v1alpha3.PatchContextSidecarInbound,
+				Listener: &v1alpha3.ListenerMatch{PortNumber: 9090},
+			}),
+			matches:  0,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:  "matching proxy version",
+			proxy: Proxy{Version: "1.6.2"},
+			patch: httpFilterPatch(v1alpha3.PatchOperationRemove, &v1alpha3.EnvoyConfigObjectMatch{
+				Context: v1alpha3.PatchContextSidecarOutbound,
+				Proxy:   &v1alpha3.ProxyMatch{ProxyVersion: `^1\.6.*`},
+				Listener: &v1alpha3.ListenerMatch{
+					FilterChain: &v1alpha3.FilterChainMatch{
+						Filter: &v1alpha3.FilterMatch{SubFilter: &v1alpha3.SubFilterMatch{Name: "envoy.cors"}},
+					},
+				},
+			}),
+			matches:  1,
+			outbound: []string{"envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:  "other proxy version",
+			proxy: Proxy{Version: "1.5.0"},
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Proxy: &v1alpha3.ProxyMatch{ProxyVersion: `^1\.6.*`},
+			}),
+			matches:  0,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+		{
+			name:  "matching proxy metadata",
+			proxy: Proxy{Metadata: map[string]string{"ISTIO_VERSION": "1.6.2", "CLUSTER_ID": "east"}},
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Context: v1alpha3.PatchContextSidecarInbound,
+				Proxy:   &v1alpha3.ProxyMatch{Metadata: map[string]string{"CLUSTER_ID": "east"}},
+			}),
+			matches:  1,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.lua", "envoy.router"},
+		},
+		{
+			name:  "other proxy metadata",
+			proxy: Proxy{Metadata: map[string]string{"CLUSTER_ID": "west"}},
+			patch: httpFilterPatch(v1alpha3.PatchOperationInsertFirst, &v1alpha3.EnvoyConfigObjectMatch{
+				Proxy: &v1alpha3.ProxyMatch{Metadata: map[string]string{"CLUSTER_ID": "east"}},
+			}),
+			matches:  0,
+			outbound: []string{"envoy.cors", "envoy.router"},
+			inbound:  []string{"envoy.router"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &Config{}
+			if err := json.Unmarshal([]byte(sidecarConfig), config); err != nil {
+				t.Fatal(err)
+			}
+
+			patched, report, err := Apply(config, tt.proxy, []*v1alpha3.EnvoyConfigObjectPatch{tt.patch})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if matches := report.Patches[0].Matches; matches != tt.matches {
+				t.Errorf("expected %d matches, got %d", tt.matches, matches)
+			}
+			if got := httpFilterNames(t, patched, "0.0.0.0_80"); !reflect.DeepEqual(got, tt.outbound) {
+				t.Errorf("outbound: expected %v, got %v", tt.outbound, got)
+			}
+			if got := httpFilterNames(t, patched, VirtualInboundListenerName); !reflect.DeepEqual(got, tt.inbound) {
+				t.Errorf("inbound: expected %v, got %v", tt.inbound, got)
+			}
+
+			if got := httpFilterNames(t, config, "0.0.0.0_80"); !reflect.DeepEqual(got, []string{"envoy.cors", "envoy.router"}) {
+				t.Errorf("the original configuration was modified: %v", got)
+			}
+		})
+	}
+}
+
+func TestApplyCopiesPatchValues(t *testing.T) {
+	config := &Config{}
+	if err := json.Unmarshal([]byte(sidecarConfig), config); err != nil {
+		t.Fatal(err)
+	}
+	patch := httpFilterPatch(v1alpha3.PatchOperationInsertFirst, nil)
+	patch.Patch.Value = json.RawMessage(`{"name": "envoy.lua", "typed_config": {"inline_code": "-- lua"}}`)
+
+	patched, _, err := Apply(config, Proxy{}, []*v1alpha3.EnvoyConfigObjectPatch{patch})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var inserted []Object
+	for _, listener := range patched.Listeners {
+		chain := objects(listener, "filter_chains")[0]
+		typedConfig := objects(chain, "filters")[0]["typed_config"].(Object)
+		inserted = append(inserted, objects(typedConfig, "http_filters")[0])
+	}
+	inserted[0]["typed_config"].(Object)["inline_code"] = "-- changed"
+	if code := inserted[1]["typed_config"].(Object)["inline_code"]; code != "-- lua" {
+		t.Errorf("inserted filters share their values: %v", code)
+	}
+}
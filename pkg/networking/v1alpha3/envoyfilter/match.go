@@ -0,0 +1,383 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package envoyfilter
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+const (
+	// VirtualInboundListenerName is the name of the listener of sidecars
+	// accepting inbound traffic, whose filter chains are matched on their
+	// destination port instead of the port of the listener.
+	VirtualInboundListenerName = "virtualInbound"
+	// HTTPConnectionManager is the name of the network filter holding HTTP
+	// filters.
+	HTTPConnectionManager = "envoy.filters.network.http_connection_manager"
+	// LegacyHTTPConnectionManager is the deprecated name of
+	// HTTPConnectionManager.
+	LegacyHTTPConnectionManager = "envoy.http_connection_manager"
+)
+
+// contextMatches reports whether the context of the patch matches the context
+// of an object.
+func (p *patcher) contextMatches(context v1alpha3.PatchContext) bool {
+	return p.match.Context == "" || p.match.Context == v1alpha3.PatchContextAny || p.match.Context == context
+}
+
+func (p *patcher) listenerContext(listener Object) v1alpha3.PatchContext {
+	if p.proxy.Gateway {
+		return v1alpha3.PatchContextGateway
+	}
+	if str(listener, "traffic_direction") == "INBOUND" || str(listener, "name") == VirtualInboundListenerName {
+		return v1alpha3.PatchContextSidecarInbound
+	}
+
+	return v1alpha3.PatchContextSidecarOutbound
+}
+
+// nameContext returns the context of route configurations and clusters,
+// whose names start with the traffic direction for inbound traffic.
+func (p *patcher) nameContext(name string) v1alpha3.PatchContext {
+	if p.proxy.Gateway {
+		return v1alpha3.PatchContextGateway
+	}
+	if strings.HasPrefix(name, "inbound|") {
+		return v1alpha3.PatchContextSidecarInbound
+	}
+
+	return v1alpha3.PatchContextSidecarOutbound
+}
+
+// listenerMatches matches the listener on its name and port. PortName is not
+// matched, as Istio does not implement it. When patching filter chains and
+// filters, the port of the virtual inbound listener is not matched, its
+// filter chains are matched on their destination port instead.
+func (p *patcher) listenerMatches(listener Object) bool {
+	if !p.contextMatches(p.listenerContext(listener)) {
+		return false
+	}
+	match := p.match.Listener
+	if match == nil {
+		return true
+	}
+	name := str(listener, "name")
+	if match.Name != "" && match.Name != name {
+		return false
+	}
+	if match.PortNumber != 0 && (p.applyTo == v1alpha3.ApplyToListener || name != VirtualInboundListenerName) &&
+		number(listener, "address", "socket_address", "port_value") != match.PortNumber {
+		return false
+	}
+
+	return true
+}
+
+// filterChainMatches matches a filter chain of the listener. The filter of
+// the match selects the chains having the filter when patching filter chains
+// only, otherwise it selects the filter to patch.
+func (p *patcher) filterChainMatches(listener Object, chain Object) bool {
+	listenerMatch := p.match.Listener
+	if listenerMatch == nil {
+		return true
+	}
+	if listenerMatch.PortNumber != 0 && str(listener, "name") == VirtualInboundListenerName &&
+		number(chain, "filter_chain_match", "destination_port") != listenerMatch.PortNumber {
+		return false
+	}
+	match := listenerMatch.FilterChain
+	if match == nil {
+		return true
+	}
+
+	if match.Name != "" && match.Name != str(chain, "name") {
+		return false
+	}
+	chainMatch, _ := chain["filter_chain_match"].(Object)
+	if match.SNI != "" && !contains(strs(chainMatch, "server_names"), match.SNI) {
+		return false
+	}
+	if match.TransportProtocol != "" && match.TransportProtocol != str(chainMatch, "transport_protocol") {
+		return false
+	}
+	if match.ApplicationProtocols != "" {
+		protocols := strs(chainMatch, "application_protocols")
+		for _, protocol := range strings.Split(match.ApplicationProtocols, ",") {
+			if !contains(protocols, strings.TrimSpace(protocol)) {
+				return false
+			}
+		}
+	}
+	if p.applyTo == v1alpha3.ApplyToFilterChain && match.Filter != nil && match.Filter.Name != "" {
+		for _, filter := range objects(chain, "filters") {
+			if str(filter, "name") == match.Filter.Name {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func (p *patcher) filterMatch() *v1alpha3.FilterMatch {
+	if p.match.Listener == nil || p.match.Listener.FilterChain == nil {
+		return nil
+	}
+
+	return p.match.Listener.FilterChain.Filter
+}
+
+func (p *patcher) networkFilterMatches(filter Object) bool {
+	match := p.filterMatch()
+
+	return match == nil || match.Name == "" || match.Name == str(filter, "name")
+}
+
+// httpConnectionManagerMatches matches the HTTP connection manager whose
+// HTTP filters are patched. The filter of the match must name the HTTP
+// connection manager if set.
+func (p *patcher) httpConnectionManagerMatches(filter Object) bool {
+	name := str(filter, "name")
+	if name != HTTPConnectionManager && name != LegacyHTTPConnectionManager {
+		return false
+	}
+
+	return p.networkFilterMatches(filter)
+}
+
+func (p *patcher) httpFilterMatches(filter Object) bool {
+	match := p.filterMatch()
+	if match == nil || match.SubFilter == nil {
+		return true
+	}
+
+	return match.SubFilter.Name == "" || match.SubFilter.Name == str(filter, "name")
+}
+
+// routeConfigurationMatches matches the route configuration on its name and
+// on the parts Istio encodes in it: the port for sidecars, "80" or
+// "inbound|80|http|host", and the port, port name and gateway for gateways,
+// "https.443.portName.gateway.namespace".
+func (p *patcher) routeConfigurationMatches(routeConfiguration Object) bool {
+	name := str(routeConfiguration, "name")
+	context := p.nameContext(name)
+	if !p.contextMatches(context) {
+		return false
+	}
+	match := p.match.RouteConfiguration
+	if match == nil {
+		return true
+	}
+	if match.Name != "" && match.Name != name {
+		return false
+	}
+
+	if context != v1alpha3.PatchContextGateway {
+		var port uint32
+		if context == v1alpha3.PatchContextSidecarInbound {
+			_, port, _, _ = parseClusterName(name)
+		} else {
+			port = parsePort(name[strings.LastIndex(name, ":")+1:])
+		}
+		return match.PortNumber == 0 || match.PortNumber == port
+	}
+
+	port, portName, gateway := parseGatewayRouteName(name)
+	if match.PortNumber != 0 && match.PortNumber != port {
+		return false
+	}
+	if match.PortName != "" && match.PortName != portName {
+		return false
+	}
+	if match.Gateway != "" && match.Gateway != gateway {
+		return false
+	}
+
+	return true
+}
+
+func (p *patcher) virtualHostMatch() *v1alpha3.VirtualHostMatch {
+	if p.match.RouteConfiguration == nil {
+		return nil
+	}
+
+	return p.match.RouteConfiguration.Vhost
+}
+
+func (p *patcher) virtualHostMatches(virtualHost Object) bool {
+	match := p.virtualHostMatch()
+
+	return match == nil || match.Name == "" || match.Name == str(virtualHost, "name")
+}
+
+func (p *patcher) routeMatches(route Object) bool {
+	vhostMatch := p.virtualHostMatch()
+	if vhostMatch == nil || vhostMatch.Route == nil {
+		return true
+	}
+	match := vhostMatch.Route
+	if match.Name != "" && match.Name != str(route, "name") {
+		return false
+	}
+
+	if match.Action == nil {
+		return true
+	}
+	switch *match.Action {
+	case v1alpha3.RouteMatchActionRoute:
+		return route["route"] != nil
+	case v1alpha3.RouteMatchActionRedirect:
+		return route["redirect"] != nil
+	case v1alpha3.RouteMatchActionDirectResponse:
+		return route["direct_response"] != nil
+	default:
+		return true
+	}
+}
+
+// clusterMatches matches the cluster on its name, or on the parts Istio
+// encodes in it, "outbound|80|subset|host".
+func (p *patcher) clusterMatches(cluster Object) bool {
+	name := str(cluster, "name")
+	if !p.contextMatches(p.nameContext(name)) {
+		return false
+	}
+	match := p.match.Cluster
+	if match == nil {
+		return true
+	}
+	if match.Name != "" {
+		return match.Name == name
+	}
+
+	_, port, subset, service := parseClusterName(name)
+	if match.PortNumber != 0 && match.PortNumber != port {
+		return false
+	}
+	if match.Subset != "" && match.Subset != subset {
+		return false
+	}
+	if match.Service != "" && match.Service != service {
+		return false
+	}
+
+	return true
+}
+
+// parseClusterName parses names of the form "direction|port|subset|host",
+// returning zero values for names of other forms.
+func parseClusterName(name string) (direction string, port uint32, subset, host string) {
+	parts := strings.Split(name, "|")
+	if len(parts) != 4 {
+		return "", 0, "", ""
+	}
+
+	return parts[0], parsePort(parts[1]), parts[2], parts[3]
+}
+
+// parseGatewayRouteName parses the names of the route configurations of
+// gateways, "http.80" for plain text servers and
+// "https.443.portName.gateway.namespace" for TLS terminating servers. The
+// gateway is returned as "namespace/gateway".
+func parseGatewayRouteName(name string) (port uint32, portName, gateway string) {
+	parts := strings.Split(name, ".")
+	switch {
+	case parts[0] == "http" && len(parts) >= 2:
+		return parsePort(parts[1]), "", ""
+	case parts[0] == "https" && len(parts) >= 5:
+		return parsePort(parts[1]), parts[2], parts[4] + "/" + parts[3]
+	default:
+		return 0, "", ""
+	}
+}
+
+func parsePort(s string) uint32 {
+	port, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0
+	}
+
+	return uint32(port)
+}
+
+func field(obj Object, path ...string) interface{} {
+	var value interface{} = obj
+	for _, key := range path {
+		o, ok := value.(Object)
+		if !ok {
+			return nil
+		}
+		value = o[key]
+	}
+
+	return value
+}
+
+func str(obj Object, path ...string) string {
+	s, _ := field(obj, path...).(string)
+
+	return s
+}
+
+// number returns a port number, which is a JSON number or a string in the
+// JSON mapping of protobuf.
+func number(obj Object, path ...string) uint32 {
+	switch value := field(obj, path...).(type) {
+	case float64:
+		return uint32(value)
+	case string:
+		return parsePort(value)
+	default:
+		return 0
+	}
+}
+
+func strs(obj Object, path ...string) []string {
+	values, _ := field(obj, path...).([]interface{})
+	var result []string
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func objects(obj Object, path ...string) []Object {
+	values, _ := field(obj, path...).([]interface{})
+	var result []Object
+	for _, value := range values {
+		if o, ok := value.(Object); ok {
+			result = append(result, o)
+		}
+	}
+
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -18,10 +18,15 @@ import (
 	"encoding/json"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io
+// +kubebuilder:storageversion
+// +k8s:conversion-gen=false
 // EnvoyFilter
 type EnvoyFilter struct {
 	v1.TypeMeta `json:",inline"`
@@ -241,6 +246,7 @@ type EnvoyConfigObjectMatch struct {
 	// The specific config generation context to match on. Istio Pilot
 	// generates envoy configuration in the context of a gateway,
 	// inbound traffic to sidecar and outbound traffic from sidecar.
+	// +optional
 	Context PatchContext `json:"context"`
 	// Match on properties associated with a proxy.
 	Proxy *ProxyMatch `json:"proxy,omitempty"`
@@ -319,15 +325,61 @@ type ProxyMatch struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// +k8s:conversion-gen=false
 // Patch specifies how the selected object should be modified.
 type Patch struct {
 	// Determines how the patch should be applied.
 	Operation PatchOperation `json:"operation,omitempty"`
 	// The JSON config of the object being patched. This will be merged using
 	// json merge semantics with the existing proto in the path.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
 	Value json.RawMessage `json:"value,omitempty"`
 }
 
+// OpenAPIDefinition is used by the kube-openapi generator, which cannot
+// describe Value, when constructing the OpenAPI spec of this type. Value is
+// described as an object whose fields are all preserved.
+func (Patch) OpenAPIDefinition() common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Patch specifies how the selected object should be modified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Determines how the patch should be applied.",
+							Type:        []string{"string"},
+							Enum: []interface{}{
+								string(PatchOperationInvalid),
+								string(PatchOperationMerge),
+								string(PatchOperationAdd),
+								string(PatchOperationRemove),
+								string(PatchOperationInsertBefore),
+								string(PatchOperationInsertAfter),
+								string(PatchOperationInsertFirst),
+							},
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The JSON config of the object being patched. This will be merged using json merge semantics with the existing proto in the path.",
+							Type:        []string{"object"},
+						},
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-preserve-unknown-fields": true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // Conditions specified in RouteConfigurationMatch must be met for
 // the patch to be applied to a route configuration object or a
 // specific virtual host within the route configuration.
@@ -433,6 +485,7 @@ type SubFilterMatch struct {
 }
 
 // ApplyTo specifies where in the Envoy configuration, the given patch should be applied.
+// +kubebuilder:validation:Enum=INVALID;LISTENER;FILTER_CHAIN;NETWORK_FILTER;HTTP_FILTER;ROUTE_CONFIGURATION;VIRTUAL_HOST;HTTP_ROUTE;CLUSTER
 type ApplyTo string
 
 const (
@@ -465,6 +518,7 @@ const (
 
 // Operation denotes how the patch should be applied to the selected
 // configuration.
+// +kubebuilder:validation:Enum=INVALID;MERGE;ADD;REMOVE;INSERT_BEFORE;INSERT_AFTER;INSERT_FIRST
 type PatchOperation string
 
 const (
@@ -511,6 +565,7 @@ const (
 
 // PatchContext selects a class of configurations based on the
 // traffic flow direction and workload type.
+// +kubebuilder:validation:Enum=ANY;SIDECAR_INBOUND;SIDECAR_OUTBOUND;GATEWAY
 type PatchContext string
 
 const (
@@ -525,6 +580,7 @@ const (
 )
 
 // Action refers to the route action taken by Envoy when a http route matches.
+// +kubebuilder:validation:Enum=ANY;ROUTE;REDIRECT;DIRECT_RESPONSE
 type RouteMatchAction string
 
 const (
@@ -539,6 +595,7 @@ const (
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:conversion-gen=false
 // EnvoyFilterList is a collection of EnvoyFilters.
 type EnvoyFilterList struct {
 	v1.TypeMeta `json:",inline"`
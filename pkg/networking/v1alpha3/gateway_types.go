@@ -20,6 +20,8 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io,shortName=gw
+// +kubebuilder:storageversion
 
 // `Gateway` describes a load balancer operating at the edge of the mesh
 // receiving incoming or outgoing HTTP/TCP connections. The specification
@@ -306,6 +308,7 @@ type Server struct {
 	// Private configurations (e.g., `exportTo` set to `.`) will not be
 	// available. Refer to the `exportTo` setting in `VirtualService`,
 	// `DestinationRule`, and `ServiceEntry` configurations for details.
+	// +kubebuilder:validation:Required
 	Hosts []string `json:"hosts,omitempty"`
 
 	// Set of TLS related options that govern the server's behavior. Use
@@ -391,6 +394,7 @@ type TLSOptions struct {
 }
 
 // TLS protocol versions.
+// +kubebuilder:validation:Enum=TLS_AUTO;TLSV1_0;TLSV1_1;TLSV1_2;TLSV1_3
 type TLSProtocol string
 
 const (
@@ -411,6 +415,7 @@ const (
 )
 
 // TLS modes enforced by the proxy
+// +kubebuilder:validation:Enum=PASSTHROUGH;SIMPLE;MUTUAL;AUTO_PASSTHROUGH;ISTIO_MUTUAL
 type TLSMode string
 
 const (
@@ -462,6 +467,10 @@ type Port struct {
 	Name string `json:"name,omitempty"`
 }
 
+// PortProtocol is the protocol exposed on a port. Istio parses protocol names
+// case insensitively, the upper case, lower case and constant spellings are
+// accepted by the CRD schema.
+// +kubebuilder:validation:Enum=HTTP;HTTPS;GRPC;GRPC-WEB;HTTP2;MONGO;TCP;TLS;http;https;grpc;grpc-web;http2;mongo;tcp;tls;GRPC-Web;Mongo
 type PortProtocol string
 
 const (
@@ -0,0 +1,215 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+func virtualService(routes ...v1alpha3.HTTPRoute) []v1alpha3.VirtualService {
+	return []v1alpha3.VirtualService{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "reviews"},
+		Spec: v1alpha3.VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP:  routes,
+		},
+	}}
+}
+
+func route(name string, match *v1alpha3.HTTPMatchRequest, rewrite *v1alpha3.HTTPRewrite) v1alpha3.HTTPRoute {
+	return v1alpha3.HTTPRoute{
+		Name:    &name,
+		Match:   []*v1alpha3.HTTPMatchRequest{match},
+		Rewrite: rewrite,
+		Route: []*v1alpha3.HTTPRouteDestination{{
+			Destination: &v1alpha3.Destination{Host: "reviews"},
+		}},
+	}
+}
+
+func uriMatch(uri v1alpha1.StringMatch, ignoreCase bool) *v1alpha3.HTTPMatchRequest {
+	return &v1alpha3.HTTPMatchRequest{URI: &uri, IgnoreURICase: &ignoreCase}
+}
+
+func TestSimulateIgnoreURICase(t *testing.T) {
+	tests := []struct {
+		name    string
+		uri     v1alpha1.StringMatch
+		path    string
+		matches bool
+	}{
+		{name: "exact", uri: v1alpha1.StringMatch{Exact: "/API/v1"}, path: "/api/V1", matches: true},
+		{name: "prefix", uri: v1alpha1.StringMatch{Prefix: "/API"}, path: "/api/v1", matches: true},
+		{name: "prefix mismatch", uri: v1alpha1.StringMatch{Prefix: "/API"}, path: "/status", matches: false},
+		{name: "suffix is case sensitive", uri: v1alpha1.StringMatch{Suffix: ".HTML"}, path: "/a.html", matches: false},
+		{name: "suffix keeps the case of the path", uri: v1alpha1.StringMatch{Suffix: ".HTML"}, path: "/a.HTML", matches: true},
+		{name: "regex is case sensitive", uri: v1alpha1.StringMatch{Regex: "/API/.*"}, path: "/api/v1", matches: false},
+		{name: "regex keeps the case of the path", uri: v1alpha1.StringMatch{Regex: "/API/.*"}, path: "/API/v1", matches: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vss := virtualService(route("match", uriMatch(tt.uri, true), nil))
+			_, err := Simulate(vss, Request{Authority: "reviews", Path: tt.path})
+			if tt.matches && err != nil {
+				t.Fatalf("expected %s to match, got %v", tt.path, err)
+			}
+			if !tt.matches && err != ErrNoRoute {
+				t.Fatalf("expected %s not to match, got %v", tt.path, err)
+			}
+		})
+	}
+}
+
+func TestSimulateRewrite(t *testing.T) {
+	uri := func(s string) *string { return &s }
+	tests := []struct {
+		name      string
+		match     *v1alpha3.HTTPMatchRequest
+		rewrite   *v1alpha3.HTTPRewrite
+		path      string
+		want      string
+		authority string
+	}{
+		{
+			name:      "prefix",
+			match:     uriMatch(v1alpha1.StringMatch{Prefix: "/v1"}, false),
+			rewrite:   &v1alpha3.HTTPRewrite{URI: uri("/v2")},
+			path:      "/v1/reviews?id=1",
+			want:      "/v2/reviews?id=1",
+			authority: "reviews",
+		},
+		{
+			name:      "exact",
+			match:     uriMatch(v1alpha1.StringMatch{Exact: "/old"}, false),
+			rewrite:   &v1alpha3.HTTPRewrite{URI: uri("/new")},
+			path:      "/old",
+			want:      "/new",
+			authority: "reviews",
+		},
+		{
+			name:      "regex replaces the whole path",
+			match:     uriMatch(v1alpha1.StringMatch{Regex: "/v[0-9]+/.*"}, false),
+			rewrite:   &v1alpha3.HTTPRewrite{URI: uri("/latest")},
+			path:      "/v1/reviews?id=1",
+			want:      "/latest?id=1",
+			authority: "reviews",
+		},
+		{
+			name:      "authority",
+			match:     uriMatch(v1alpha1.StringMatch{Prefix: "/"}, false),
+			rewrite:   &v1alpha3.HTTPRewrite{Authority: uri("ratings")},
+			path:      "/v1",
+			want:      "/v1",
+			authority: "ratings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vss := virtualService(route("rewrite", tt.match, tt.rewrite))
+			result, err := Simulate(vss, Request{Authority: "reviews", Path: tt.path})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Path != tt.want {
+				t.Errorf("path: expected %s, got %s", tt.want, result.Path)
+			}
+			if result.Authority != tt.authority {
+				t.Errorf("authority: expected %s, got %s", tt.authority, result.Authority)
+			}
+		})
+	}
+}
+
+func TestSimulateHosts(t *testing.T) {
+	vs := func(namespace, name string, gateways []string, hosts ...string) v1alpha3.VirtualService {
+		return v1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: v1alpha3.VirtualServiceSpec{
+				Hosts:    hosts,
+				Gateways: gateways,
+				HTTP: []v1alpha3.HTTPRoute{{
+					Route: []*v1alpha3.HTTPRouteDestination{{
+						Destination: &v1alpha3.Destination{Host: hosts[0]},
+					}},
+				}},
+			},
+		}
+	}
+	virtualServices := []v1alpha3.VirtualService{
+		vs("default", "short", nil, "reviews"),
+		vs("prod", "fqdn", nil, "ratings.prod.svc.cluster.local"),
+		vs("prod", "wildcard", nil, "*.prod.svc.cluster.local"),
+		vs("default", "gateway", []string{"ingress"}, "details"),
+	}
+
+	tests := []struct {
+		name          string
+		clusterDomain string
+		request       Request
+		vs            string
+	}{
+		{name: "short host", request: Request{Authority: "reviews", Namespace: "default"}, vs: "short"},
+		{name: "short host by fqdn", request: Request{Authority: "reviews.default.svc.cluster.local", Namespace: "prod"}, vs: "short"},
+		{name: "short host with namespace", request: Request{Authority: "reviews.default", Namespace: "prod"}, vs: "short"},
+		{name: "short host with svc", request: Request{Authority: "reviews.default.svc:9080", Namespace: "prod"}, vs: "short"},
+		{name: "short host with port and case", request: Request{Authority: "Reviews:9080", Namespace: "default"}, vs: "short"},
+		{name: "short host from its namespace by default", request: Request{Authority: "reviews"}, vs: "short"},
+		{name: "short host from another namespace", request: Request{Authority: "reviews", Namespace: "prod"}, vs: "wildcard"},
+		{name: "fqdn host", request: Request{Authority: "ratings.prod.svc.cluster.local", Namespace: "default"}, vs: "fqdn"},
+		{name: "fqdn host by short name", request: Request{Authority: "ratings", Namespace: "prod"}, vs: "fqdn"},
+		{name: "fqdn host with namespace", request: Request{Authority: "ratings.prod", Namespace: "default"}, vs: "fqdn"},
+		{name: "fqdn host from another namespace", request: Request{Authority: "ratings", Namespace: "default"}},
+		{name: "wildcard host", request: Request{Authority: "productpage", Namespace: "prod"}, vs: "wildcard"},
+		{
+			name:          "cluster domain",
+			clusterDomain: "example.org",
+			request:       Request{Authority: "reviews.default.svc.example.org", Namespace: "prod"},
+			vs:            "short",
+		},
+		{
+			name:          "other cluster domain",
+			clusterDomain: "example.org",
+			request:       Request{Authority: "reviews.default.svc.cluster.local", Namespace: "default"},
+		},
+		{name: "gateway by fqdn", request: Request{Authority: "details.default.svc.cluster.local", Gateway: "default/ingress"}, vs: "gateway"},
+		{name: "gateway by short name", request: Request{Authority: "details", Gateway: "default/ingress"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Simulator{ClusterDomain: tt.clusterDomain, VirtualServices: virtualServices}
+			result, err := s.Simulate(tt.request)
+			if tt.vs == "" {
+				if err != ErrNoVirtualService {
+					t.Fatalf("expected %v, got %v", ErrNoVirtualService, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.VirtualService.Name != tt.vs {
+				t.Errorf("expected virtual service %s, got %s", tt.vs, result.VirtualService.Name)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 // enforcement, etc. When communicating with services outside the mesh,
 // Istio's mTLS authentication is disabled, and policy enforcement is
 // performed on the client-side as opposed to server-side.
+// +kubebuilder:validation:Enum=MESH_EXTERNAL;MESH_INTERNAL
 type ServiceEntryLocation string
 
 const (
@@ -47,6 +48,7 @@ const (
 // Proxy. Alternatively, for HTTP services, the application could
 // directly communicate with the proxy (e.g., by setting HTTP_PROXY) to
 // talk to these services.
+// +kubebuilder:validation:Enum=NONE;STATIC;DNS
 type ServiceEntryResolution string
 
 const (
@@ -74,6 +76,8 @@ const (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io,shortName=se
+// +kubebuilder:storageversion
 
 // `ServiceEntry` enables adding additional entries into Istio's internal
 // service registry, so that auto-discovered services in the mesh can
@@ -404,6 +408,7 @@ type ServiceEntrySpec struct {
 	// Note that when resolution is set to type DNS
 	// and no endpoints are specified, the host field will be used as the DNS name
 	// of the endpoint to route traffic to.
+	// +kubebuilder:validation:Required
 	Hosts []string `json:"hosts,omitempty"`
 
 	// The virtual IP addresses associated with the service. Could be CIDR
@@ -425,6 +430,7 @@ type ServiceEntrySpec struct {
 	// REQUIRED. The ports associated with the external service. If the
 	// Endpoints are Unix domain socket addresses, there must be exactly one
 	// port.
+	// +kubebuilder:validation:Required
 	Ports []*Port `json:"ports,omitempty"`
 
 	// Specify whether the service should be considered external to the mesh
@@ -476,6 +482,7 @@ type ServiceEntryEndpoint struct {
 	// port.  Domain names can be used if and only if the resolution is set
 	// to DNS, and must be fully-qualified without wildcards. Use the form
 	// unix:///absolute/path/to/socket for Unix domain socket endpoints.
+	// +kubebuilder:validation:Required
 	Address *string `json:"address,omitempty"`
 
 	// Set of ports associated with the endpoint. The ports must be
@@ -20,6 +20,8 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io
+// +kubebuilder:storageversion
 
 // Sidecar describes the configuration of the sidecar proxy that mediates
 // inbound and outbound communication to the workload instance it is attached to. By
@@ -247,6 +249,7 @@ type SidecarSpec struct {
 	// Egress specifies the configuration of the sidecar for processing
 	// outbound traffic from the attached workload instance to other services in the
 	// mesh.
+	// +optional
 	Egress []*IstioEgressListener `json:"egress"`
 	// This allows to configure the outbound traffic policy.
 	// If your application uses one or more external
@@ -269,6 +272,7 @@ type OutboundTrafficPolicy struct {
 	Mode *OutboundTrafficPolicyMode `json:"mode,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=REGISTRY_ONLY;ALLOW_ANY
 type OutboundTrafficPolicyMode string
 
 const (
@@ -384,6 +388,7 @@ type WorkloadSelector struct {
 
 // CaptureMode describes how traffic to a listener is expected to be
 // captured. Applicable only when the listener is bound to an IP.
+// +kubebuilder:validation:Enum=DEFAULT;IPTABLES;NONE
 type CaptureMode string
 
 const (
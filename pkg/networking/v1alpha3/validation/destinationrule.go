@@ -0,0 +1,292 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+var (
+	supportedSimpleLBs = sets.NewString(
+		string(v1alpha3.SimpleLBRoundRobin),
+		string(v1alpha3.SimpleLBLeastConn),
+		string(v1alpha3.SimpleLBRandom),
+		string(v1alpha3.SimpleLBPassthrough),
+	)
+
+	supportedH2UpgradePolicies = sets.NewString(
+		string(v1alpha3.H2UpgradePolicyDefault),
+		string(v1alpha3.H2UpgradePolicyDoNotUpgrade),
+		string(v1alpha3.H2UpgradePolicyUpgrade),
+	)
+
+	supportedTLSmodes = sets.NewString(
+		string(v1alpha3.TLSmodeDisable),
+		string(v1alpha3.TLSmodeSimple),
+		string(v1alpha3.TLSmodeMutual),
+		string(v1alpha3.TLSmodeIstioMutual),
+	)
+)
+
+// ValidateDestinationRule validates the spec of a DestinationRule.
+func ValidateDestinationRule(dr *v1alpha3.DestinationRule) field.ErrorList {
+	return ValidateDestinationRuleSpec(&dr.Spec, field.NewPath("spec"))
+}
+
+// ValidateDestinationRuleSpec validates a DestinationRuleSpec.
+func ValidateDestinationRuleSpec(spec *v1alpha3.DestinationRuleSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, commonvalidation.ValidateWildcardDomain(spec.Host, fldPath.Child("host"))...)
+
+	if spec.TrafficPolicy != nil {
+		allErrs = append(allErrs, ValidateTrafficPolicy(spec.TrafficPolicy, fldPath.Child("trafficPolicy"))...)
+	}
+
+	subsetNames := sets.NewString()
+	for i := range spec.Subsets {
+		subset := &spec.Subsets[i]
+		subsetPath := fldPath.Child("subsets").Index(i)
+		if subsetNames.Has(subset.Name) {
+			allErrs = append(allErrs, field.Duplicate(subsetPath.Child("name"), subset.Name))
+		}
+		subsetNames.Insert(subset.Name)
+		allErrs = append(allErrs, validateSubset(subset, subsetPath)...)
+	}
+
+	allErrs = append(allErrs, validateExportTo(spec.ExportTo, fldPath.Child("exportTo"))...)
+
+	return allErrs
+}
+
+func validateSubset(subset *v1alpha3.Subset, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSubsetName(subset.Name, fldPath.Child("name"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(subset.Labels, fldPath.Child("labels"))...)
+	if subset.TrafficPolicy != nil {
+		allErrs = append(allErrs, ValidateTrafficPolicy(subset.TrafficPolicy, fldPath.Child("trafficPolicy"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateTrafficPolicy validates a TrafficPolicy including its port level settings.
+func ValidateTrafficPolicy(policy *v1alpha3.TrafficPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateTrafficPolicyCommon(&policy.TrafficPolicyCommon, fldPath)...)
+
+	ports := sets.NewInt()
+	for i := range policy.PortLevelSettings {
+		settings := &policy.PortLevelSettings[i]
+		settingsPath := fldPath.Child("portLevelSettings").Index(i)
+		if settings.Port == nil {
+			allErrs = append(allErrs, field.Required(settingsPath.Child("port"), ""))
+		} else {
+			allErrs = append(allErrs, validatePortSelector(settings.Port, settingsPath.Child("port"))...)
+			if ports.Has(int(settings.Port.Number)) {
+				allErrs = append(allErrs, field.Duplicate(settingsPath.Child("port", "number"), settings.Port.Number))
+			}
+			ports.Insert(int(settings.Port.Number))
+		}
+		allErrs = append(allErrs, validateTrafficPolicyCommon(&settings.TrafficPolicyCommon, settingsPath)...)
+	}
+
+	return allErrs
+}
+
+func validateTrafficPolicyCommon(policy *v1alpha3.TrafficPolicyCommon, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.LoadBalancer != nil {
+		allErrs = append(allErrs, validateLoadBalancerSettings(policy.LoadBalancer, fldPath.Child("loadBalancer"))...)
+	}
+	if policy.ConnectionPool != nil {
+		allErrs = append(allErrs, validateConnectionPoolSettings(policy.ConnectionPool, fldPath.Child("connectionPool"))...)
+	}
+	if policy.OutlierDetection != nil {
+		allErrs = append(allErrs, validateOutlierDetection(policy.OutlierDetection, fldPath.Child("outlierDetection"))...)
+	}
+	if policy.TLS != nil {
+		allErrs = append(allErrs, validateTLSSettings(policy.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerSettings(settings *v1alpha3.LoadBalancerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case settings.Simple == nil && settings.ConsistentHash == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of simple or consistentHash is required"))
+	case settings.Simple != nil && settings.ConsistentHash != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, "simple, consistentHash", "only one of simple or consistentHash may be set"))
+	}
+
+	if settings.Simple != nil && !supportedSimpleLBs.Has(string(*settings.Simple)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("simple"), *settings.Simple, supportedSimpleLBs.List()))
+	}
+	if settings.ConsistentHash != nil {
+		allErrs = append(allErrs, validateConsistentHashLB(settings.ConsistentHash, fldPath.Child("consistentHash"))...)
+	}
+
+	return allErrs
+}
+
+func validateConsistentHashLB(lb *v1alpha3.ConsistentHashLB, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var set []string
+	if lb.HTTPHeaderName != nil {
+		set = append(set, "httpHeaderName")
+		if *lb.HTTPHeaderName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("httpHeaderName"), ""))
+		}
+	}
+	if lb.HTTPCookie != nil {
+		set = append(set, "httpCookie")
+		allErrs = append(allErrs, validateHTTPCookie(lb.HTTPCookie, fldPath.Child("httpCookie"))...)
+	}
+	if lb.UseSourceIP != nil && *lb.UseSourceIP {
+		set = append(set, "useSourceIp")
+	}
+
+	switch len(set) {
+	case 0:
+		allErrs = append(allErrs, field.Required(fldPath, "one of httpHeaderName, httpCookie or useSourceIp is required"))
+	case 1:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, strings.Join(set, ", "), "only one of httpHeaderName, httpCookie or useSourceIp may be set"))
+	}
+
+	return allErrs
+}
+
+func validateHTTPCookie(cookie *v1alpha3.HTTPCookie, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cookie.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if cookie.TTL.Duration == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ttl"), ""))
+	} else if cookie.TTL.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), cookie.TTL.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateConnectionPoolSettings(settings *v1alpha3.ConnectionPoolSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings.TCP == nil && settings.HTTP == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "one of tcp or http is required"))
+	}
+
+	if tcp := settings.TCP; tcp != nil {
+		tcpPath := fldPath.Child("tcp")
+		allErrs = append(allErrs, validateNonNegative(tcp.MaxConnections, tcpPath.Child("maxConnections"))...)
+		if tcp.ConnectTimeout != nil {
+			allErrs = append(allErrs, commonvalidation.ValidateDuration(*tcp.ConnectTimeout, tcpPath.Child("connectTimeout"))...)
+		}
+		if keepalive := tcp.TCPKeepalive; keepalive != nil {
+			keepalivePath := tcpPath.Child("tcpKeepalive")
+			if keepalive.Time != nil {
+				allErrs = append(allErrs, commonvalidation.ValidateDuration(*keepalive.Time, keepalivePath.Child("time"))...)
+			}
+			if keepalive.Interval != nil {
+				allErrs = append(allErrs, commonvalidation.ValidateDuration(*keepalive.Interval, keepalivePath.Child("interval"))...)
+			}
+		}
+	}
+
+	if http := settings.HTTP; http != nil {
+		httpPath := fldPath.Child("http")
+		allErrs = append(allErrs, validateNonNegative(http.HTTP1MaxPendingRequests, httpPath.Child("http1MaxPendingRequests"))...)
+		allErrs = append(allErrs, validateNonNegative(http.HTTP2MaxRequests, httpPath.Child("http2MaxRequests"))...)
+		allErrs = append(allErrs, validateNonNegative(http.MaxRequestsPerConnection, httpPath.Child("maxRequestsPerConnection"))...)
+		allErrs = append(allErrs, validateNonNegative(http.MaxRetries, httpPath.Child("maxRetries"))...)
+		if http.IdleTimeout != nil {
+			allErrs = append(allErrs, commonvalidation.ValidateDuration(*http.IdleTimeout, httpPath.Child("idleTimeout"))...)
+		}
+		if http.H2UpgradePolicy != nil && !supportedH2UpgradePolicies.Has(string(*http.H2UpgradePolicy)) {
+			allErrs = append(allErrs, field.NotSupported(httpPath.Child("h2UpgradePolicy"), *http.H2UpgradePolicy, supportedH2UpgradePolicies.List()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateOutlierDetection(detection *v1alpha3.OutlierDetection, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateNonNegative(&detection.ConsecutiveErrors, fldPath.Child("consecutiveErrors"))...)
+	if detection.Interval != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateDuration(*detection.Interval, fldPath.Child("interval"))...)
+	}
+	if detection.BaseEjectionTime != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateDuration(*detection.BaseEjectionTime, fldPath.Child("baseEjectionTime"))...)
+	}
+	if detection.MaxEjectionPercent != nil {
+		allErrs = append(allErrs, commonvalidation.ValidatePercent(int64(*detection.MaxEjectionPercent), fldPath.Child("maxEjectionPercent"))...)
+	}
+	if detection.MinHealthPercent != nil {
+		allErrs = append(allErrs, commonvalidation.ValidatePercent(int64(*detection.MinHealthPercent), fldPath.Child("minHealthPercent"))...)
+	}
+
+	return allErrs
+}
+
+func validateTLSSettings(settings *v1alpha3.TLSSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedTLSmodes.Has(string(settings.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), settings.Mode, supportedTLSmodes.List()))
+	}
+	if settings.CredentialName != nil && *settings.CredentialName != "" {
+		files := []struct {
+			name  string
+			value *string
+		}{
+			{name: "clientCertificate", value: settings.ClientCertificate},
+			{name: "privateKey", value: settings.PrivateKey},
+			{name: "caCertificates", value: settings.CaCertificates},
+		}
+		for _, file := range files {
+			if file.value != nil && *file.value != "" {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child(file.name), "may not be set together with credentialName"))
+			}
+		}
+	} else if settings.Mode == v1alpha3.TLSmodeMutual {
+		if settings.ClientCertificate == nil || *settings.ClientCertificate == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clientCertificate"), "client certificate is required for mutual TLS"))
+		}
+		if settings.PrivateKey == nil || *settings.PrivateKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("privateKey"), "private key is required for mutual TLS"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,261 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+var (
+	supportedPortProtocols = []v1alpha3.PortProtocol{
+		v1alpha3.ProtocolHTTP,
+		v1alpha3.ProtocolHTTPS,
+		v1alpha3.ProtocolGRPC,
+		v1alpha3.ProtocolGRPCWeb,
+		v1alpha3.ProtocolHTTP2,
+		v1alpha3.ProtocolMongo,
+		v1alpha3.ProtocolTCP,
+		v1alpha3.ProtocolTLS,
+	}
+
+	supportedTLSModes = sets.NewString(
+		string(v1alpha3.TLSModePassThrough),
+		string(v1alpha3.TLSModeSimple),
+		string(v1alpha3.TLSModeMutual),
+		string(v1alpha3.TLSModeMutualAutoPassThrough),
+		string(v1alpha3.TLSModeIstioMutual),
+	)
+
+	tlsProtocolVersions = map[v1alpha3.TLSProtocol]int{
+		v1alpha3.TLSProtocolAuto: 0,
+		v1alpha3.TLSProtocolV10:  1,
+		v1alpha3.TLSProtocolV11:  2,
+		v1alpha3.TLSProtocolV12:  3,
+		v1alpha3.TLSProtocolV13:  4,
+	}
+)
+
+// ValidateGateway validates the spec of a Gateway.
+func ValidateGateway(gw *v1alpha3.Gateway) field.ErrorList {
+	return ValidateGatewaySpec(&gw.Spec, gw.Namespace, field.NewPath("spec"))
+}
+
+// ValidateGatewaySpec validates the GatewaySpec of a Gateway in the namespace.
+// Server hosts are expected in the namespace/host form, where the namespace
+// part defaults to "*" when omitted and "." stands for the namespace of the
+// Gateway. Servers sharing a port must not claim overlapping hosts.
+func ValidateGatewaySpec(spec *v1alpha3.GatewaySpec, namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Servers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("servers"), "at least one server is required"))
+	}
+
+	portNames := sets.NewString()
+	for i := range spec.Servers {
+		server := &spec.Servers[i]
+		serverPath := fldPath.Child("servers").Index(i)
+		allErrs = append(allErrs, ValidateServer(server, serverPath)...)
+
+		if server.Port == nil || server.Port.Name == "" {
+			continue
+		}
+		if portNames.Has(server.Port.Name) {
+			allErrs = append(allErrs, field.Duplicate(serverPath.Child("port", "name"), server.Port.Name))
+		}
+		portNames.Insert(server.Port.Name)
+	}
+	allErrs = append(allErrs, validateServerHostOverlaps(spec.Servers, namespace, fldPath.Child("servers"))...)
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(spec.Selector, fldPath.Child("selector"))...)
+
+	return allErrs
+}
+
+// ValidateServer validates a single Server of a Gateway.
+func ValidateServer(server *v1alpha3.Server, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(server.Hosts) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hosts"), "at least one host is required"))
+	}
+	for i, host := range server.Hosts {
+		allErrs = append(allErrs, validateNamespacedHost(host, fldPath.Child("hosts").Index(i))...)
+	}
+
+	if server.Port == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("port"), ""))
+	} else {
+		allErrs = append(allErrs, validatePort(server.Port, fldPath.Child("port"))...)
+		protocol := v1alpha3.PortProtocol(strings.ToUpper(string(server.Port.Protocol)))
+		if (protocol == v1alpha3.ProtocolHTTPS || protocol == v1alpha3.ProtocolTLS) && (server.TLS == nil || server.TLS.Mode == "") {
+			allErrs = append(allErrs, field.Required(fldPath.Child("tls", "mode"), fmt.Sprintf("TLS mode is required for %s servers", server.Port.Protocol)))
+		}
+	}
+
+	if server.TLS != nil {
+		allErrs = append(allErrs, validateTLSOptions(server.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+func validatePort(port *v1alpha3.Port, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, commonvalidation.ValidatePort(int64(port.Number), fldPath.Child("number"))...)
+	if port.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if !isSupportedPortProtocol(port.Protocol) {
+		values := make([]string, len(supportedPortProtocols))
+		for i, protocol := range supportedPortProtocols {
+			values[i] = string(protocol)
+		}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), port.Protocol, values))
+	}
+
+	return allErrs
+}
+
+// isSupportedPortProtocol matches protocol names case insensitively, the same
+// way Istio parses them.
+func isSupportedPortProtocol(protocol v1alpha3.PortProtocol) bool {
+	for _, supported := range supportedPortProtocols {
+		if strings.EqualFold(string(protocol), string(supported)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateTLSOptions(tls *v1alpha3.TLSOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if tls.Mode != "" && !supportedTLSModes.Has(string(tls.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), tls.Mode, supportedTLSModes.List()))
+	}
+
+	if tls.Mode == v1alpha3.TLSModeSimple || tls.Mode == v1alpha3.TLSModeMutual {
+		if tls.CredentialName == nil || *tls.CredentialName == "" {
+			if tls.ServerCertificate == nil || *tls.ServerCertificate == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("serverCertificate"), fmt.Sprintf("%s mode requires either credentialName or a server certificate and private key", tls.Mode)))
+			}
+			if tls.PrivateKey == nil || *tls.PrivateKey == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("privateKey"), fmt.Sprintf("%s mode requires either credentialName or a server certificate and private key", tls.Mode)))
+			}
+			if tls.Mode == v1alpha3.TLSModeMutual && (tls.CaCertificates == nil || *tls.CaCertificates == "") {
+				allErrs = append(allErrs, field.Required(fldPath.Child("caCertificates"), "MUTUAL mode requires either credentialName or a CA bundle"))
+			}
+		}
+	}
+
+	minVersion, maxVersion := -1, -1
+	if tls.MinProtocolVersion != nil {
+		version, ok := tlsProtocolVersions[*tls.MinProtocolVersion]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("minProtocolVersion"), *tls.MinProtocolVersion, tlsProtocolVersionNames()))
+		}
+		minVersion = version
+	}
+	if tls.MaxProtocolVersion != nil {
+		version, ok := tlsProtocolVersions[*tls.MaxProtocolVersion]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("maxProtocolVersion"), *tls.MaxProtocolVersion, tlsProtocolVersionNames()))
+		}
+		maxVersion = version
+	}
+	if minVersion > 0 && maxVersion > 0 && minVersion > maxVersion {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minProtocolVersion"), *tls.MinProtocolVersion, fmt.Sprintf("must not be greater than maxProtocolVersion %s", *tls.MaxProtocolVersion)))
+	}
+
+	return allErrs
+}
+
+func tlsProtocolVersionNames() []string {
+	names := sets.NewString()
+	for version := range tlsProtocolVersions {
+		names.Insert(string(version))
+	}
+
+	return names.List()
+}
+
+// validateNamespacedHost validates a host in the namespace/host form used by
+// gateway servers. The namespace may be "*", "." or a namespace name.
+func validateNamespacedHost(namespacedHost string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	h, err := host.Parse(namespacedHost)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, namespacedHost, err.Error()))
+	}
+	if h.Namespace != host.AnyNamespace && h.Namespace != host.CurrentNamespace {
+		for _, msg := range validation.IsDNS1123Label(h.Namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath, namespacedHost, "namespace "+msg))
+		}
+	}
+	allErrs = append(allErrs, commonvalidation.ValidateWildcardDomain(string(h.Name), fldPath)...)
+
+	return allErrs
+}
+
+func validateServerHostOverlaps(servers []v1alpha3.Server, namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i := range servers {
+		if servers[i].Port == nil {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if servers[j].Port == nil || servers[j].Port.Number != servers[i].Port.Number {
+				continue
+			}
+			for k, host := range servers[i].Hosts {
+				for _, other := range servers[j].Hosts {
+					if namespacedHostsOverlap(host, other, namespace) {
+						allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("hosts").Index(k), host,
+							fmt.Sprintf("overlaps with host %q of servers[%d] on port %d", other, j, servers[i].Port.Number)))
+					}
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// namespacedHostsOverlap reports whether two server hosts select a service in
+// common once "." is resolved to the namespace of the Gateway.
+func namespacedHostsOverlap(a, b, namespace string) bool {
+	aHost, aErr := host.Parse(a)
+	bHost, bErr := host.Parse(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+
+	return aHost.Resolve(namespace).Intersects(bHost.Resolve(namespace))
+}
@@ -0,0 +1,282 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// invalidFields returns the paths of the invalid fields in the order they
+// were reported.
+func invalidFields(errs field.ErrorList) []string {
+	fields := []string{}
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+
+	return fields
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func gateway(namespace string, servers ...v1alpha3.Server) *v1alpha3.Gateway {
+	return &v1alpha3.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "gateway"},
+		Spec:       v1alpha3.GatewaySpec{Servers: servers},
+	}
+}
+
+func server(name string, number int, protocol v1alpha3.PortProtocol, tls *v1alpha3.TLSOptions, hosts ...string) v1alpha3.Server {
+	return v1alpha3.Server{
+		Port:  &v1alpha3.Port{Number: number, Protocol: protocol, Name: name},
+		Hosts: hosts,
+		TLS:   tls,
+	}
+}
+
+func TestValidateGatewayHostOverlaps(t *testing.T) {
+	tests := []struct {
+		name    string
+		gateway *v1alpha3.Gateway
+		fields  []string
+	}{
+		{
+			name: "distinct hosts",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "a.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "b.example.com"),
+			),
+		},
+		{
+			name: "same host",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "a.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "a.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[0]"},
+		},
+		{
+			name: "same host on other ports",
+			gateway: gateway("istio-system",
+				server("http", 80, v1alpha3.ProtocolHTTP, nil, "a.example.com"),
+				server("http-alt", 8080, v1alpha3.ProtocolHTTP, nil, "a.example.com"),
+			),
+		},
+		{
+			name: "current namespace and the namespace of the gateway",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "./a.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "istio-system/a.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[0]"},
+		},
+		{
+			name: "current namespace and another namespace",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "./a.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "prod/a.example.com"),
+			),
+		},
+		{
+			name: "any namespace",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "*/a.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "prod/a.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[0]"},
+		},
+		{
+			name: "wildcard and a host it matches",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "*.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "other.com", "api.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[1]"},
+		},
+		{
+			name: "wildcard and the domain itself",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "*.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "example.com"),
+			),
+		},
+		{
+			name: "overlapping wildcards",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "*.example.com"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "*.api.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[0]"},
+		},
+		{
+			name: "catch all",
+			gateway: gateway("istio-system",
+				server("http-a", 80, v1alpha3.ProtocolHTTP, nil, "*"),
+				server("http-b", 80, v1alpha3.ProtocolHTTP, nil, "prod/api.example.com"),
+			),
+			fields: []string{"spec.servers[1].hosts[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := invalidFields(ValidateGateway(tt.gateway))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateGatewayHosts(t *testing.T) {
+	tests := []struct {
+		host  string
+		valid bool
+	}{
+		{host: "*", valid: true},
+		{host: "example.com", valid: true},
+		{host: "*.example.com", valid: true},
+		{host: "*example.com", valid: true},
+		{host: "prod/*.example.com", valid: true},
+		{host: "./example.com", valid: true},
+		{host: "*/example.com", valid: true},
+		{host: "api.*.com", valid: false},
+		{host: "example..com", valid: false},
+		{host: "Prod/example.com", valid: false},
+		{host: "~/example.com", valid: false},
+		{host: "prod/", valid: false},
+		{host: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		errs := ValidateGateway(gateway("istio-system", server("http", 80, v1alpha3.ProtocolHTTP, nil, tt.host)))
+		if valid := len(errs) == 0; valid != tt.valid {
+			t.Errorf("%q: expected valid: %t, got %v", tt.host, tt.valid, errs)
+		}
+	}
+}
+
+func TestValidateGatewayTLS(t *testing.T) {
+	tls12 := v1alpha3.TLSProtocolV12
+	tls13 := v1alpha3.TLSProtocolV13
+
+	tests := []struct {
+		name     string
+		protocol v1alpha3.PortProtocol
+		tls      *v1alpha3.TLSOptions
+		fields   []string
+	}{
+		{
+			name:     "plain text",
+			protocol: v1alpha3.ProtocolHTTP,
+		},
+		{
+			name:     "https redirect",
+			protocol: v1alpha3.ProtocolHTTP,
+			tls:      &v1alpha3.TLSOptions{HTTPSRedirect: new(bool)},
+		},
+		{
+			name:     "https without a mode",
+			protocol: v1alpha3.ProtocolHTTPS,
+			fields:   []string{"spec.servers[0].tls.mode"},
+		},
+		{
+			name:     "lower case tls without a mode",
+			protocol: "tls",
+			tls:      &v1alpha3.TLSOptions{},
+			fields:   []string{"spec.servers[0].tls.mode"},
+		},
+		{
+			name:     "passthrough",
+			protocol: v1alpha3.ProtocolTLS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModePassThrough},
+		},
+		{
+			name:     "simple with a secret",
+			protocol: v1alpha3.ProtocolHTTPS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModeSimple, CredentialName: stringPtr("example-cert")},
+		},
+		{
+			name:     "simple with files",
+			protocol: v1alpha3.ProtocolHTTPS,
+			tls: &v1alpha3.TLSOptions{
+				Mode:              v1alpha3.TLSModeSimple,
+				ServerCertificate: stringPtr("/etc/certs/cert.pem"),
+				PrivateKey:        stringPtr("/etc/certs/key.pem"),
+			},
+		},
+		{
+			name:     "simple without certificates",
+			protocol: v1alpha3.ProtocolHTTPS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModeSimple},
+			fields:   []string{"spec.servers[0].tls.serverCertificate", "spec.servers[0].tls.privateKey"},
+		},
+		{
+			name:     "mutual without a CA bundle",
+			protocol: v1alpha3.ProtocolHTTPS,
+			tls: &v1alpha3.TLSOptions{
+				Mode:              v1alpha3.TLSModeMutual,
+				ServerCertificate: stringPtr("/etc/certs/cert.pem"),
+				PrivateKey:        stringPtr("/etc/certs/key.pem"),
+			},
+			fields: []string{"spec.servers[0].tls.caCertificates"},
+		},
+		{
+			name:     "mutual with a secret",
+			protocol: v1alpha3.ProtocolHTTPS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModeMutual, CredentialName: stringPtr("example-cert")},
+		},
+		{
+			name:     "unknown mode",
+			protocol: v1alpha3.ProtocolTLS,
+			tls:      &v1alpha3.TLSOptions{Mode: "OPTIONAL"},
+			fields:   []string{"spec.servers[0].tls.mode"},
+		},
+		{
+			name:     "protocol versions",
+			protocol: v1alpha3.ProtocolTLS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModePassThrough, MinProtocolVersion: &tls12, MaxProtocolVersion: &tls13},
+		},
+		{
+			name:     "minimum version above the maximum",
+			protocol: v1alpha3.ProtocolTLS,
+			tls:      &v1alpha3.TLSOptions{Mode: v1alpha3.TLSModePassThrough, MinProtocolVersion: &tls13, MaxProtocolVersion: &tls12},
+			fields:   []string{"spec.servers[0].tls.minProtocolVersion"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := invalidFields(ValidateGateway(gateway("istio-system", server("port", 443, tt.protocol, tt.tls, "example.com"))))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
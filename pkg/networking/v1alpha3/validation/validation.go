@@ -0,0 +1,125 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validation implements the semantic checks Istio applies to
+// networking resources before accepting them.
+package validation
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+// MeshGateway is the reserved gateway name referring to all sidecars in the mesh.
+const MeshGateway = "mesh"
+
+func validateDestination(destination *v1alpha3.Destination, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if destination == nil {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+
+	if destination.Host == "*" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("host"), destination.Host, "must not be a wildcard"))
+	} else {
+		allErrs = append(allErrs, commonvalidation.ValidateWildcardDomain(destination.Host, fldPath.Child("host"))...)
+	}
+	if destination.Subset != nil {
+		allErrs = append(allErrs, validateSubsetName(*destination.Subset, fldPath.Child("subset"))...)
+	}
+	if destination.Port != nil {
+		allErrs = append(allErrs, validatePortSelector(destination.Port, fldPath.Child("port"))...)
+	}
+
+	return allErrs
+}
+
+func validateSubsetName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == "" {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	for _, msg := range validation.IsDNS1123Label(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	return allErrs
+}
+
+func validatePortSelector(selector *v1alpha3.PortSelector, fldPath *field.Path) field.ErrorList {
+	return commonvalidation.ValidatePort(int64(selector.Number), fldPath.Child("number"))
+}
+
+func validateGatewayName(name string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if name == MeshGateway {
+		return allErrs
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 {
+		for _, msg := range validation.IsDNS1123Label(parts[0]) {
+			allErrs = append(allErrs, field.Invalid(fldPath, name, "namespace "+msg))
+		}
+		name = parts[1]
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	return allErrs
+}
+
+func validateExportTo(exportTo []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, target := range exportTo {
+		if target == "." || target == "*" {
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Label(target) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), target, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePercentage(percentage *v1alpha3.Percentage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if percentage.Value < 0 || percentage.Value > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), percentage.Value, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateNonNegative(value *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value != nil && *value < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *value, "must not be negative"))
+	}
+
+	return allErrs
+}
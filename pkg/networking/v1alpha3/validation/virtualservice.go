@@ -0,0 +1,381 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3"
+)
+
+var (
+	supportedRedirectCodes = sets.NewInt(301, 302, 303, 307, 308)
+
+	supportedRetryOnPolicies = sets.NewString(
+		"5xx",
+		"gateway-error",
+		"reset",
+		"connect-failure",
+		"retriable-4xx",
+		"refused-stream",
+		"retriable-status-codes",
+		"retriable-headers",
+		"cancelled",
+		"deadline-exceeded",
+		"internal",
+		"resource-exhausted",
+		"unavailable",
+	)
+)
+
+// ValidateVirtualService validates the spec of a VirtualService.
+func ValidateVirtualService(vs *v1alpha3.VirtualService) field.ErrorList {
+	return ValidateVirtualServiceSpec(&vs.Spec, field.NewPath("spec"))
+}
+
+// ValidateVirtualServiceSpec validates a VirtualServiceSpec.
+func ValidateVirtualServiceSpec(spec *v1alpha3.VirtualServiceSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	appliesToMesh := len(spec.Gateways) == 0
+	for i, gateway := range spec.Gateways {
+		allErrs = append(allErrs, validateGatewayName(gateway, fldPath.Child("gateways").Index(i))...)
+		if gateway == MeshGateway {
+			appliesToMesh = true
+		}
+	}
+
+	if len(spec.Hosts) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hosts"), "at least one host is required"))
+	}
+	for i, host := range spec.Hosts {
+		hostPath := fldPath.Child("hosts").Index(i)
+		if appliesToMesh && host == "*" {
+			allErrs = append(allErrs, field.Invalid(hostPath, host, "wildcard host is not allowed for virtual services bound to the mesh gateway"))
+			continue
+		}
+		allErrs = append(allErrs, commonvalidation.ValidateWildcardDomainOrIP(host, hostPath)...)
+	}
+
+	if len(spec.HTTP) == 0 && len(spec.TLS) == 0 && len(spec.TCP) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "one of http, tls or tcp routes must be provided"))
+	}
+	for i := range spec.HTTP {
+		allErrs = append(allErrs, ValidateHTTPRoute(&spec.HTTP[i], fldPath.Child("http").Index(i))...)
+	}
+	for i := range spec.TLS {
+		allErrs = append(allErrs, ValidateTLSRoute(&spec.TLS[i], fldPath.Child("tls").Index(i))...)
+	}
+	for i := range spec.TCP {
+		allErrs = append(allErrs, ValidateTCPRoute(&spec.TCP[i], fldPath.Child("tcp").Index(i))...)
+	}
+
+	allErrs = append(allErrs, validateExportTo(spec.ExportTo, fldPath.Child("exportTo"))...)
+
+	return allErrs
+}
+
+// ValidateHTTPRoute validates a single HTTPRoute.
+func ValidateHTTPRoute(route *v1alpha3.HTTPRoute, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if route.Redirect != nil {
+		if len(route.Route) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("route"), "may not be set together with redirect"))
+		}
+		if route.Rewrite != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rewrite"), "may not be set together with redirect"))
+		}
+		if route.Fault != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("fault"), "may not be set together with redirect"))
+		}
+		allErrs = append(allErrs, validateHTTPRedirect(route.Redirect, fldPath.Child("redirect"))...)
+	} else if len(route.Route) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("route"), "one of route or redirect is required"))
+	}
+
+	for i, match := range route.Match {
+		allErrs = append(allErrs, validateHTTPMatchRequest(match, fldPath.Child("match").Index(i))...)
+	}
+
+	allErrs = append(allErrs, validateHTTPRouteDestinations(route.Route, fldPath.Child("route"))...)
+
+	if route.Rewrite != nil && route.Rewrite.URI == nil && route.Rewrite.Authority == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rewrite"), "one of uri or authority is required"))
+	}
+	if route.Timeout != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateDuration(*route.Timeout, fldPath.Child("timeout"))...)
+	}
+	if route.Retries != nil {
+		allErrs = append(allErrs, validateHTTPRetry(route.Retries, fldPath.Child("retries"))...)
+	}
+	if route.Fault != nil {
+		allErrs = append(allErrs, validateHTTPFaultInjection(route.Fault, fldPath.Child("fault"))...)
+	}
+	if route.Mirror != nil {
+		allErrs = append(allErrs, validateDestination(route.Mirror, fldPath.Child("mirror"))...)
+	}
+	if route.MirrorPercent != nil {
+		allErrs = append(allErrs, commonvalidation.ValidatePercent(int64(*route.MirrorPercent), fldPath.Child("mirrorPercent"))...)
+	}
+	if route.CorsPolicy != nil {
+		allErrs = append(allErrs, validateCorsPolicy(route.CorsPolicy, fldPath.Child("corsPolicy"))...)
+	}
+
+	return allErrs
+}
+
+func validateHTTPMatchRequest(match *v1alpha3.HTTPMatchRequest, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if match == nil {
+		return allErrs
+	}
+
+	if match.URI != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(match.URI, fldPath.Child("uri"))...)
+	}
+	if match.Scheme != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(match.Scheme, fldPath.Child("scheme"))...)
+	}
+	if match.Method != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(match.Method, fldPath.Child("method"))...)
+	}
+	if match.Authority != nil {
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(match.Authority, fldPath.Child("authority"))...)
+	}
+	for _, name := range sets.StringKeySet(match.Headers).List() {
+		header := match.Headers[name]
+		headerPath := fldPath.Child("headers").Key(name)
+		if name == "" {
+			allErrs = append(allErrs, field.Invalid(headerPath, name, "header name must not be empty"))
+		} else if strings.ToLower(name) != name {
+			allErrs = append(allErrs, field.Invalid(headerPath, name, "header name must be lowercase"))
+		}
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(&header, headerPath)...)
+	}
+	for _, name := range sets.StringKeySet(match.QueryParams).List() {
+		param := match.QueryParams[name]
+		paramPath := fldPath.Child("queryParams").Key(name)
+		if param == nil {
+			allErrs = append(allErrs, field.Required(paramPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, commonvalidation.ValidateStringMatch(param, paramPath)...)
+	}
+	if match.Port != nil {
+		allErrs = append(allErrs, commonvalidation.ValidatePort(int64(*match.Port), fldPath.Child("port"))...)
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(match.SourceLabels, fldPath.Child("sourceLabels"))...)
+
+	return allErrs
+}
+
+func validateHTTPRouteDestinations(destinations []*v1alpha3.HTTPRouteDestination, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var totalWeight int
+	for i, destination := range destinations {
+		if destination == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+			continue
+		}
+		allErrs = append(allErrs, validateDestination(destination.Destination, fldPath.Index(i).Child("destination"))...)
+		if destination.Weight != nil {
+			allErrs = append(allErrs, commonvalidation.ValidatePercent(int64(*destination.Weight), fldPath.Index(i).Child("weight"))...)
+			totalWeight += *destination.Weight
+		}
+	}
+	if len(destinations) > 1 && totalWeight != 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath, totalWeight, "total destination weight must be 100"))
+	}
+
+	return allErrs
+}
+
+func validateRouteDestinations(destinations []*v1alpha3.RouteDestination, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(destinations) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one destination is required"))
+	}
+
+	var totalWeight int
+	for i, destination := range destinations {
+		if destination == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+			continue
+		}
+		allErrs = append(allErrs, validateDestination(destination.Destination, fldPath.Index(i).Child("destination"))...)
+		if destination.Weight != nil {
+			allErrs = append(allErrs, commonvalidation.ValidatePercent(int64(*destination.Weight), fldPath.Index(i).Child("weight"))...)
+			totalWeight += *destination.Weight
+		}
+	}
+	if len(destinations) > 1 && totalWeight != 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath, totalWeight, "total destination weight must be 100"))
+	}
+
+	return allErrs
+}
+
+func validateHTTPRedirect(redirect *v1alpha3.HTTPRedirect, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if redirect.URI == nil && redirect.Authority == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "one of uri or authority is required"))
+	}
+	if redirect.RedirectCode != nil && !supportedRedirectCodes.Has(int(*redirect.RedirectCode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("redirectCode"), *redirect.RedirectCode, []string{"301", "302", "303", "307", "308"}))
+	}
+
+	return allErrs
+}
+
+func validateHTTPRetry(retry *v1alpha3.HTTPRetry, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if retry.Attempts < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("attempts"), retry.Attempts, "must not be negative"))
+	}
+	if retry.PerTryTimeout.Duration != 0 {
+		allErrs = append(allErrs, commonvalidation.ValidateDuration(retry.PerTryTimeout, fldPath.Child("perTryTimeout"))...)
+	}
+	if retry.RetryOn != nil {
+		for _, policy := range strings.Split(*retry.RetryOn, ",") {
+			policy = strings.TrimSpace(policy)
+			if supportedRetryOnPolicies.Has(policy) {
+				continue
+			}
+			if code, err := strconv.Atoi(policy); err == nil && code >= 100 && code <= 599 {
+				continue
+			}
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("retryOn"), policy, "must be a supported retry policy or an HTTP status code"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateHTTPFaultInjection(fault *v1alpha3.HTTPFaultInjection, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if fault.Delay == nil && fault.Abort == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "one of delay or abort is required"))
+	}
+	if fault.Delay != nil {
+		delayPath := fldPath.Child("delay")
+		if fault.Delay.FixedDelay.Duration == 0 {
+			allErrs = append(allErrs, field.Required(delayPath.Child("fixedDelay"), ""))
+		} else {
+			allErrs = append(allErrs, commonvalidation.ValidateDuration(fault.Delay.FixedDelay, delayPath.Child("fixedDelay"))...)
+		}
+		if fault.Delay.Percentage != nil {
+			allErrs = append(allErrs, validatePercentage(fault.Delay.Percentage, delayPath.Child("percentage"))...)
+		}
+	}
+	if fault.Abort != nil {
+		abortPath := fldPath.Child("abort")
+		if fault.Abort.HTTPStatus < 200 || fault.Abort.HTTPStatus > 599 {
+			allErrs = append(allErrs, field.Invalid(abortPath.Child("httpStatus"), fault.Abort.HTTPStatus, "must be between 200 and 599"))
+		}
+		if fault.Abort.Percentage != nil {
+			allErrs = append(allErrs, validatePercentage(fault.Abort.Percentage, abortPath.Child("percentage"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateCorsPolicy(policy *v1alpha3.CorsPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy.MaxAge != nil {
+		errs := commonvalidation.ValidateDuration(*policy.MaxAge, fldPath.Child("maxAge"))
+		if len(errs) == 0 {
+			if policy.MaxAge.Duration%time.Second != 0 {
+				errs = append(errs, field.Invalid(fldPath.Child("maxAge"), policy.MaxAge.String(), "only durations to second precision are supported"))
+			}
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs
+}
+
+// ValidateTLSRoute validates a single TLSRoute.
+func ValidateTLSRoute(route *v1alpha3.TLSRoute, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(route.Match) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("match"), "at least one match condition is required"))
+	}
+	for i := range route.Match {
+		allErrs = append(allErrs, validateTLSMatchAttributes(&route.Match[i], fldPath.Child("match").Index(i))...)
+	}
+	allErrs = append(allErrs, validateRouteDestinations(route.Route, fldPath.Child("route"))...)
+
+	return allErrs
+}
+
+func validateTLSMatchAttributes(match *v1alpha3.TLSMatchAttributes, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(match.SniHosts) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sniHosts"), "at least one SNI host is required"))
+	}
+	for i, host := range match.SniHosts {
+		allErrs = append(allErrs, commonvalidation.ValidateWildcardDomain(host, fldPath.Child("sniHosts").Index(i))...)
+	}
+	allErrs = append(allErrs, validateL4Match(match.DestinationSubnets, match.Port, match.SourceLabels, match.Gateways, fldPath)...)
+
+	return allErrs
+}
+
+// ValidateTCPRoute validates a single TCPRoute.
+func ValidateTCPRoute(route *v1alpha3.TCPRoute, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, match := range route.Match {
+		allErrs = append(allErrs, validateL4Match(match.DestinationSubnets, match.Port, match.SourceLabels, match.Gateways, fldPath.Child("match").Index(i))...)
+	}
+	allErrs = append(allErrs, validateRouteDestinations(route.Route, fldPath.Child("route"))...)
+
+	return allErrs
+}
+
+func validateL4Match(subnets []string, port *int, sourceLabels map[string]string, gateways []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, subnet := range subnets {
+		allErrs = append(allErrs, commonvalidation.ValidateIPOrCIDR(subnet, fldPath.Child("destinationSubnets").Index(i))...)
+	}
+	if port != nil {
+		allErrs = append(allErrs, commonvalidation.ValidatePort(int64(*port), fldPath.Child("port"))...)
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(sourceLabels, fldPath.Child("sourceLabels"))...)
+	for i, gateway := range gateways {
+		allErrs = append(allErrs, validateGatewayName(gateway, fldPath.Child("gateways").Index(i))...)
+	}
+
+	return allErrs
+}
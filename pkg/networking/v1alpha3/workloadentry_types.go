@@ -18,15 +18,17 @@ import v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io,shortName=we
+// +kubebuilder:storageversion
 // WorkloadEntry
 type WorkloadEntry struct {
-	v1.TypeMeta
+	v1.TypeMeta `json:",inline"`
 	// +optional
-	v1.ObjectMeta
+	v1.ObjectMeta `json:"metadata,omitempty"`
 
 	// Spec defines the implementation of this definition.
 	// +optional
-	Spec WorkloadEntrySpec
+	Spec WorkloadEntrySpec `json:"spec"`
 }
 
 // `WorkloadEntry` enables operators to describe the properties of a
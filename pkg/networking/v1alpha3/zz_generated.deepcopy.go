@@ -1,4 +1,4 @@
-// +build !ignore_autogenerated
+//go:build !ignore_autogenerated
 
 // Copyright © 2019 Banzai Cloud
 //
@@ -144,7 +144,7 @@ func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 	}
 	if in.MaxAge != nil {
 		in, out := &in.MaxAge, &out.MaxAge
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.AllowCredentials != nil {
@@ -167,6 +167,7 @@ func (in *CorsPolicy) DeepCopy() *CorsPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Delay) DeepCopyInto(out *Delay) {
 	*out = *in
+	out.FixedDelay = in.FixedDelay
 	if in.Percentage != nil {
 		in, out := &in.Percentage, &out.Percentage
 		*out = new(Percentage)
@@ -239,7 +240,7 @@ func (in *DestinationRule) DeepCopyObject() runtime.Object {
 func (in *DestinationRuleList) DeepCopyInto(out *DestinationRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]DestinationRule, len(*in))
@@ -389,7 +390,7 @@ func (in *EnvoyFilter) DeepCopyObject() runtime.Object {
 func (in *EnvoyFilterList) DeepCopyInto(out *EnvoyFilterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]EnvoyFilter, len(*in))
@@ -518,7 +519,7 @@ func (in *Gateway) DeepCopyObject() runtime.Object {
 func (in *GatewayList) DeepCopyInto(out *GatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Gateway, len(*in))
@@ -583,6 +584,7 @@ func (in *HTTPCookie) DeepCopyInto(out *HTTPCookie) {
 		*out = new(string)
 		**out = **in
 	}
+	out.TTL = in.TTL
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCookie.
@@ -675,7 +677,8 @@ func (in *HTTPMatchRequest) DeepCopyInto(out *HTTPMatchRequest) {
 			if val == nil {
 				(*out)[key] = nil
 			} else {
-				in, out := &val, &outVal
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(v1alpha1.StringMatch)
 				**out = **in
 			}
@@ -732,6 +735,7 @@ func (in *HTTPRedirect) DeepCopy() *HTTPRedirect {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
 	*out = *in
+	out.PerTryTimeout = in.PerTryTimeout
 	if in.RetryOn != nil {
 		in, out := &in.RetryOn, &out.RetryOn
 		*out = new(string)
@@ -816,7 +820,7 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.Retries != nil {
@@ -916,7 +920,7 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 	}
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.H2UpgradePolicy != nil {
@@ -1147,12 +1151,12 @@ func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	*out = *in
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.BaseEjectionTime != nil {
 		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.MaxEjectionPercent != nil {
@@ -1464,7 +1468,7 @@ func (in *ServiceEntryEndpoint) DeepCopy() *ServiceEntryEndpoint {
 func (in *ServiceEntryList) DeepCopyInto(out *ServiceEntryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]ServiceEntry, len(*in))
@@ -1589,7 +1593,7 @@ func (in *Sidecar) DeepCopyObject() runtime.Object {
 func (in *SidecarList) DeepCopyInto(out *SidecarList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Sidecar, len(*in))
@@ -1716,12 +1720,12 @@ func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	}
 	if in.Time != nil {
 		in, out := &in.Time, &out.Time
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 }
@@ -1779,7 +1783,7 @@ func (in *TCPSettings) DeepCopyInto(out *TCPSettings) {
 	}
 	if in.ConnectTimeout != nil {
 		in, out := &in.ConnectTimeout, &out.ConnectTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.TCPKeepalive != nil {
@@ -1972,6 +1976,11 @@ func (in *TLSSettings) DeepCopyInto(out *TLSSettings) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CredentialName != nil {
+		in, out := &in.CredentialName, &out.CredentialName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSettings.
@@ -2092,7 +2101,7 @@ func (in *VirtualService) DeepCopyObject() runtime.Object {
 func (in *VirtualServiceList) DeepCopyInto(out *VirtualServiceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]VirtualService, len(*in))
@@ -2201,7 +2210,7 @@ func (in *WorkloadEntry) DeepCopyObject() runtime.Object {
 func (in *WorkloadEntryList) DeepCopyInto(out *WorkloadEntryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]WorkloadEntry, len(*in))
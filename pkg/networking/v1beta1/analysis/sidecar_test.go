@@ -0,0 +1,224 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func selectingSidecar(namespace, name string, labels map[string]string) v1beta1.Sidecar {
+	s := sidecar(namespace, name)
+	s.Spec.WorkloadSelector = &v1beta1.WorkloadSelector{Labels: labels}
+
+	return s
+}
+
+func TestSelectSidecar(t *testing.T) {
+	reviews := selectingSidecar("default", "reviews", map[string]string{"app": "reviews"})
+	ratings := selectingSidecar("default", "ratings", map[string]string{"app": "ratings"})
+	namespaceDefault := sidecar("default", "default")
+	rootDefault := sidecar(DefaultRootNamespace, "default")
+	rootSelecting := selectingSidecar(DefaultRootNamespace, "reviews", map[string]string{"app": "reviews"})
+	olderReviews := selectingSidecar("default", "older-reviews", map[string]string{"version": "v1"})
+	olderReviews.CreationTimestamp.Time = created.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		sidecars      []v1beta1.Sidecar
+		rootNamespace string
+		namespace     string
+		sidecar       string
+	}{
+		{
+			name:      "workload selector over namespace and root defaults",
+			sidecars:  []v1beta1.Sidecar{rootDefault, namespaceDefault, ratings, reviews},
+			namespace: "default",
+			sidecar:   "default/reviews",
+		},
+		{
+			name:      "namespace default over root default",
+			sidecars:  []v1beta1.Sidecar{rootDefault, ratings, namespaceDefault},
+			namespace: "default",
+			sidecar:   "default/default",
+		},
+		{
+			name:      "root default",
+			sidecars:  []v1beta1.Sidecar{rootDefault, rootSelecting, ratings},
+			namespace: "default",
+			sidecar:   "istio-system/default",
+		},
+		{
+			name:      "workload selectors of the root namespace are ignored",
+			sidecars:  []v1beta1.Sidecar{rootSelecting},
+			namespace: "default",
+		},
+		{
+			name:      "sidecars of other namespaces are ignored",
+			sidecars:  []v1beta1.Sidecar{namespaceDefault, reviews},
+			namespace: "other",
+		},
+		{
+			name:          "configured root namespace",
+			sidecars:      []v1beta1.Sidecar{rootDefault, sidecar("mesh-config", "default")},
+			rootNamespace: "mesh-config",
+			namespace:     "other",
+			sidecar:       "mesh-config/default",
+		},
+		{
+			name:      "oldest of the matching sidecars",
+			sidecars:  []v1beta1.Sidecar{reviews, olderReviews},
+			namespace: "default",
+			sidecar:   "default/older-reviews",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := &Snapshot{Sidecars: tt.sidecars, RootNamespace: tt.rootNamespace}
+			workload := Workload{Namespace: tt.namespace, Labels: map[string]string{"app": "reviews", "version": "v1"}}
+			name := ""
+			if sidecar := SelectSidecar(snapshot, workload); sidecar != nil {
+				name = sidecar.Namespace + "/" + sidecar.Name
+			}
+			if name != tt.sidecar {
+				t.Errorf("expected %q, got %q", tt.sidecar, name)
+			}
+		})
+	}
+}
+
+func TestResolveSidecar(t *testing.T) {
+	private := service("other", "private")
+	private.Annotations = map[string]string{ExportToAnnotation: "other, istio-system"}
+	local := v1beta1.ServiceEntry{
+		ObjectMeta: objectMeta("other", "local", 0),
+		Spec:       v1beta1.ServiceEntrySpec{Hosts: []string{"local.example.com"}, ExportTo: []string{"."}},
+	}
+	snapshot := Snapshot{
+		Services: []corev1.Service{service("default", "reviews"), service("default", "ratings"), service("other", "details"), private},
+		ServiceEntries: []v1beta1.ServiceEntry{
+			{ObjectMeta: objectMeta("default", "api", 0), Spec: v1beta1.ServiceEntrySpec{Hosts: []string{"api.example.com"}}},
+			local,
+		},
+		VirtualServices: []v1beta1.VirtualService{
+			virtualService("default", "reviews", v1beta1.VirtualServiceSpec{Hosts: []string{"reviews"}}),
+			virtualService("default", "ingress", v1beta1.VirtualServiceSpec{Hosts: []string{"ratings"}, Gateways: []string{"ingress"}}),
+			virtualService("other", "details", v1beta1.VirtualServiceSpec{Hosts: []string{"details"}, Gateways: []string{"ingress", MeshGateway}}),
+			virtualService("other", "hidden", v1beta1.VirtualServiceSpec{Hosts: []string{"details"}, ExportTo: []string{"."}}),
+		},
+		DestinationRules: []v1beta1.DestinationRule{
+			{ObjectMeta: objectMeta("default", "all", 0), Spec: v1beta1.DestinationRuleSpec{Host: "*.svc.cluster.local"}},
+			{ObjectMeta: objectMeta("default", "api", 0), Spec: v1beta1.DestinationRuleSpec{Host: "api.example.com"}},
+			{ObjectMeta: objectMeta("other", "details", 0), Spec: v1beta1.DestinationRuleSpec{Host: "details", ExportTo: []string{"default"}}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		sidecars         []v1beta1.Sidecar
+		services         []string
+		virtualServices  []string
+		destinationRules []string
+		serviceEntries   []string
+	}{
+		{
+			name: "every visible service without a sidecar",
+			services: []string{
+				"default/api.example.com",
+				"other/details.other.svc.cluster.local",
+				"default/ratings.default.svc.cluster.local",
+				"default/reviews.default.svc.cluster.local",
+			},
+			virtualServices:  []string{"default/reviews", "other/details"},
+			destinationRules: []string{"default/all", "default/api", "other/details"},
+			serviceEntries:   []string{"default/api"},
+		},
+		{
+			name:             "services of the current namespace",
+			sidecars:         []v1beta1.Sidecar{sidecar("default", "default", "./*")},
+			services:         []string{"default/api.example.com", "default/ratings.default.svc.cluster.local", "default/reviews.default.svc.cluster.local"},
+			virtualServices:  []string{"default/reviews"},
+			destinationRules: []string{"default/all", "default/api"},
+			serviceEntries:   []string{"default/api"},
+		},
+		{
+			name:             "current namespace of a root default",
+			sidecars:         []v1beta1.Sidecar{sidecar(DefaultRootNamespace, "default", "./reviews.default.svc.cluster.local")},
+			services:         []string{"default/reviews.default.svc.cluster.local"},
+			virtualServices:  []string{"default/reviews"},
+			destinationRules: []string{"default/all"},
+		},
+		{
+			name:             "hosts of other namespaces",
+			sidecars:         []v1beta1.Sidecar{sidecar("default", "default", "other/*", "*/api.example.com")},
+			services:         []string{"default/api.example.com", "other/details.other.svc.cluster.local"},
+			virtualServices:  []string{"other/details"},
+			destinationRules: []string{"default/all", "default/api", "other/details"},
+			serviceEntries:   []string{"default/api"},
+		},
+		{
+			name:     "nothing imported",
+			sidecars: []v1beta1.Sidecar{sidecar("default", "default", "~/*")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := snapshot
+			snapshot.Sidecars = tt.sidecars
+			effective := ResolveSidecar(&snapshot, Workload{Namespace: "default", Labels: map[string]string{"app": "reviews"}})
+
+			services := []string{}
+			for _, svc := range effective.Services {
+				services = append(services, svc.Namespace+"/"+string(svc.Name))
+			}
+			virtualServices := []string{}
+			for _, vs := range effective.VirtualServices {
+				virtualServices = append(virtualServices, vs.Namespace+"/"+vs.Name)
+			}
+			destinationRules := []string{}
+			for _, dr := range effective.DestinationRules {
+				destinationRules = append(destinationRules, dr.Namespace+"/"+dr.Name)
+			}
+			serviceEntries := []string{}
+			for _, se := range effective.ServiceEntries {
+				serviceEntries = append(serviceEntries, se.Namespace+"/"+se.Name)
+			}
+
+			for _, got := range []struct {
+				kind               string
+				expected, resolved []string
+			}{
+				{"services", tt.services, services},
+				{"virtual services", tt.virtualServices, virtualServices},
+				{"destination rules", tt.destinationRules, destinationRules},
+				{"service entries", tt.serviceEntries, serviceEntries},
+			} {
+				if got.expected == nil {
+					got.expected = []string{}
+				}
+				if !reflect.DeepEqual(got.resolved, got.expected) {
+					t.Errorf("%s: expected %v, got %v", got.kind, got.expected, got.resolved)
+				}
+			}
+		})
+	}
+}
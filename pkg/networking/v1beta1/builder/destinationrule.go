@@ -0,0 +1,277 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1/validation"
+)
+
+// DestinationRuleBuilder builds a DestinationRule.
+type DestinationRuleBuilder struct {
+	dr v1beta1.DestinationRule
+}
+
+// NewDestinationRule starts building a DestinationRule for the host.
+func NewDestinationRule(namespace, name, host string) *DestinationRuleBuilder {
+	return &DestinationRuleBuilder{
+		dr: v1beta1.DestinationRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1beta1.SchemeGroupVersion.String(),
+				Kind:       "DestinationRule",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: v1beta1.DestinationRuleSpec{
+				Host: host,
+			},
+		},
+	}
+}
+
+// Labels sets the labels of the DestinationRule.
+func (b *DestinationRuleBuilder) Labels(labels map[string]string) *DestinationRuleBuilder {
+	b.dr.Labels = labels
+	return b
+}
+
+// TrafficPolicy sets the traffic policy applying to the whole host.
+func (b *DestinationRuleBuilder) TrafficPolicy(policy *TrafficPolicyBuilder) *DestinationRuleBuilder {
+	b.dr.Spec.TrafficPolicy = policy.policy.DeepCopy()
+	return b
+}
+
+// Subsets adds subsets of the host.
+func (b *DestinationRuleBuilder) Subsets(subsets ...*SubsetBuilder) *DestinationRuleBuilder {
+	for _, subset := range subsets {
+		b.dr.Spec.Subsets = append(b.dr.Spec.Subsets, *subset.subset.DeepCopy())
+	}
+	return b
+}
+
+// ExportTo adds namespaces the DestinationRule is exported to.
+func (b *DestinationRuleBuilder) ExportTo(namespaces ...string) *DestinationRuleBuilder {
+	b.dr.Spec.ExportTo = append(b.dr.Spec.ExportTo, namespaces...)
+	return b
+}
+
+// Build validates and returns the DestinationRule.
+func (b *DestinationRuleBuilder) Build() (*v1beta1.DestinationRule, error) {
+	dr := b.dr.DeepCopy()
+	if errs := validation.ValidateDestinationRule(dr); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+
+	return dr, nil
+}
+
+// SubsetBuilder builds a Subset.
+type SubsetBuilder struct {
+	subset v1beta1.Subset
+}
+
+// Subset starts building a subset of the endpoints with the labels.
+func Subset(name string, labels map[string]string) *SubsetBuilder {
+	return &SubsetBuilder{
+		subset: v1beta1.Subset{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+}
+
+// TrafficPolicy sets the traffic policy of the subset, overriding the one of
+// the host.
+func (b *SubsetBuilder) TrafficPolicy(policy *TrafficPolicyBuilder) *SubsetBuilder {
+	b.subset.TrafficPolicy = policy.policy.DeepCopy()
+	return b
+}
+
+// TrafficPolicyBuilder builds a TrafficPolicy.
+type TrafficPolicyBuilder struct {
+	policy v1beta1.TrafficPolicy
+}
+
+// TrafficPolicy starts building a traffic policy.
+func TrafficPolicy() *TrafficPolicyBuilder {
+	return &TrafficPolicyBuilder{}
+}
+
+// LoadBalancer sets a simple load balancing algorithm.
+func (b *TrafficPolicyBuilder) LoadBalancer(simple v1beta1.SimpleLB) *TrafficPolicyBuilder {
+	b.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{Simple: &simple}
+	return b
+}
+
+// ConsistentHashByHeader sets consistent hash load balancing on the value
+// of the request header.
+func (b *TrafficPolicyBuilder) ConsistentHashByHeader(name string) *TrafficPolicyBuilder {
+	b.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{
+		ConsistentHash: &v1beta1.ConsistentHashLB{HTTPHeaderName: stringPtr(name)},
+	}
+	return b
+}
+
+// ConsistentHashByCookie sets consistent hash load balancing on the value
+// of the cookie, which is generated with the ttl when missing.
+func (b *TrafficPolicyBuilder) ConsistentHashByCookie(name string, ttl time.Duration) *TrafficPolicyBuilder {
+	b.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{
+		ConsistentHash: &v1beta1.ConsistentHashLB{
+			HTTPCookie: &v1beta1.HTTPCookie{Name: name, TTL: v1alpha1.Duration{Duration: ttl}},
+		},
+	}
+	return b
+}
+
+// ConsistentHashBySourceIP sets consistent hash load balancing on the source
+// IP address.
+func (b *TrafficPolicyBuilder) ConsistentHashBySourceIP() *TrafficPolicyBuilder {
+	b.policy.LoadBalancer = &v1beta1.LoadBalancerSettings{
+		ConsistentHash: &v1beta1.ConsistentHashLB{UseSourceIP: boolPtr(true)},
+	}
+	return b
+}
+
+// MaxConnections limits the number of connections to each endpoint.
+func (b *TrafficPolicyBuilder) MaxConnections(connections int32) *TrafficPolicyBuilder {
+	b.tcpSettings().MaxConnections = int32Ptr(connections)
+	return b
+}
+
+// ConnectTimeout sets the TCP connection timeout.
+func (b *TrafficPolicyBuilder) ConnectTimeout(timeout time.Duration) *TrafficPolicyBuilder {
+	b.tcpSettings().ConnectTimeout = v1alpha1.NewDuration(timeout)
+	return b
+}
+
+// HTTP1MaxPendingRequests limits the number of requests queued while waiting
+// for a connection.
+func (b *TrafficPolicyBuilder) HTTP1MaxPendingRequests(requests int32) *TrafficPolicyBuilder {
+	b.httpSettings().HTTP1MaxPendingRequests = int32Ptr(requests)
+	return b
+}
+
+// HTTP2MaxRequests limits the number of requests to the backend.
+func (b *TrafficPolicyBuilder) HTTP2MaxRequests(requests int32) *TrafficPolicyBuilder {
+	b.httpSettings().HTTP2MaxRequests = int32Ptr(requests)
+	return b
+}
+
+// MaxRequestsPerConnection limits the number of requests sent over a single
+// connection.
+func (b *TrafficPolicyBuilder) MaxRequestsPerConnection(requests int32) *TrafficPolicyBuilder {
+	b.httpSettings().MaxRequestsPerConnection = int32Ptr(requests)
+	return b
+}
+
+func (b *TrafficPolicyBuilder) tcpSettings() *v1beta1.TCPSettings {
+	if b.policy.ConnectionPool == nil {
+		b.policy.ConnectionPool = &v1beta1.ConnectionPoolSettings{}
+	}
+	if b.policy.ConnectionPool.TCP == nil {
+		b.policy.ConnectionPool.TCP = &v1beta1.TCPSettings{}
+	}
+
+	return b.policy.ConnectionPool.TCP
+}
+
+func (b *TrafficPolicyBuilder) httpSettings() *v1beta1.HTTPSettings {
+	if b.policy.ConnectionPool == nil {
+		b.policy.ConnectionPool = &v1beta1.ConnectionPoolSettings{}
+	}
+	if b.policy.ConnectionPool.HTTP == nil {
+		b.policy.ConnectionPool.HTTP = &v1beta1.HTTPSettings{}
+	}
+
+	return b.policy.ConnectionPool.HTTP
+}
+
+// OutlierDetection ejects endpoints from the pool after consecutiveErrors
+// errors. Endpoints are scanned every interval and stay ejected for at least
+// baseEjectionTime, with at most maxEjectionPercent of them ejected at once.
+func (b *TrafficPolicyBuilder) OutlierDetection(consecutiveErrors int32, interval, baseEjectionTime time.Duration, maxEjectionPercent int32) *TrafficPolicyBuilder {
+	b.policy.OutlierDetection = &v1beta1.OutlierDetection{
+		ConsecutiveErrors:  consecutiveErrors,
+		Interval:           v1alpha1.NewDuration(interval),
+		BaseEjectionTime:   v1alpha1.NewDuration(baseEjectionTime),
+		MaxEjectionPercent: int32Ptr(maxEjectionPercent),
+	}
+	return b
+}
+
+// TLS sets the TLS mode of connections to the endpoints.
+func (b *TrafficPolicyBuilder) TLS(mode v1beta1.TLSmode) *TrafficPolicyBuilder {
+	b.tls().Mode = mode
+	return b
+}
+
+// MutualTLS presents the client certificate and private key read from the
+// files at the given paths. The server certificate is verified with the
+// caCertificates file, unless it is empty.
+func (b *TrafficPolicyBuilder) MutualTLS(clientCertificate, privateKey, caCertificates string) *TrafficPolicyBuilder {
+	tls := b.tls()
+	tls.Mode = v1beta1.TLSmodeMutual
+	tls.ClientCertificate = stringPtr(clientCertificate)
+	tls.PrivateKey = stringPtr(privateKey)
+	if caCertificates != "" {
+		tls.CaCertificates = stringPtr(caCertificates)
+	}
+	return b
+}
+
+// CredentialName reads the client certificates from the secret named
+// credentialName instead of files.
+func (b *TrafficPolicyBuilder) CredentialName(credentialName string) *TrafficPolicyBuilder {
+	b.tls().CredentialName = stringPtr(credentialName)
+	return b
+}
+
+// SNI sets the server name presented during the TLS handshake.
+func (b *TrafficPolicyBuilder) SNI(sni string) *TrafficPolicyBuilder {
+	b.tls().SNI = stringPtr(sni)
+	return b
+}
+
+// SubjectAltNames adds the names accepted in the server certificate.
+func (b *TrafficPolicyBuilder) SubjectAltNames(names ...string) *TrafficPolicyBuilder {
+	tls := b.tls()
+	tls.SubjectAltNames = append(tls.SubjectAltNames, names...)
+	return b
+}
+
+func (b *TrafficPolicyBuilder) tls() *v1beta1.TLSSettings {
+	if b.policy.TLS == nil {
+		b.policy.TLS = &v1beta1.TLSSettings{}
+	}
+
+	return b.policy.TLS
+}
+
+// PortLevel overrides the settings for a port with the ones of the policy.
+// Port level settings of the given policy are ignored.
+func (b *TrafficPolicyBuilder) PortLevel(port uint32, policy *TrafficPolicyBuilder) *TrafficPolicyBuilder {
+	b.policy.PortLevelSettings = append(b.policy.PortLevelSettings, v1beta1.PortTrafficPolicy{
+		TrafficPolicyCommon: *policy.policy.TrafficPolicyCommon.DeepCopy(),
+		Port:                &v1beta1.PortSelector{Number: port},
+	})
+	return b
+}
@@ -0,0 +1,102 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func TestTrafficPolicyTLS(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *TrafficPolicyBuilder
+		tls    *v1beta1.TLSSettings
+		valid  bool
+	}{
+		{
+			name:   "istio mutual",
+			policy: TrafficPolicy().TLS(v1beta1.TLSmodeIstioMutual),
+			tls:    &v1beta1.TLSSettings{Mode: v1beta1.TLSmodeIstioMutual},
+			valid:  true,
+		},
+		{
+			name:   "mutual",
+			policy: TrafficPolicy().MutualTLS("/etc/certs/cert.pem", "/etc/certs/key.pem", "/etc/certs/ca.pem").SNI("reviews.example.com"),
+			tls: &v1beta1.TLSSettings{
+				Mode:              v1beta1.TLSmodeMutual,
+				ClientCertificate: stringPtr("/etc/certs/cert.pem"),
+				PrivateKey:        stringPtr("/etc/certs/key.pem"),
+				CaCertificates:    stringPtr("/etc/certs/ca.pem"),
+				SNI:               stringPtr("reviews.example.com"),
+			},
+			valid: true,
+		},
+		{
+			name:   "mutual without verifying the server",
+			policy: TrafficPolicy().MutualTLS("/etc/certs/cert.pem", "/etc/certs/key.pem", ""),
+			tls: &v1beta1.TLSSettings{
+				Mode:              v1beta1.TLSmodeMutual,
+				ClientCertificate: stringPtr("/etc/certs/cert.pem"),
+				PrivateKey:        stringPtr("/etc/certs/key.pem"),
+			},
+			valid: true,
+		},
+		{
+			name:   "mutual with a secret",
+			policy: TrafficPolicy().TLS(v1beta1.TLSmodeMutual).CredentialName("reviews-client").SubjectAltNames("reviews"),
+			tls: &v1beta1.TLSSettings{
+				Mode:            v1beta1.TLSmodeMutual,
+				CredentialName:  stringPtr("reviews-client"),
+				SubjectAltNames: []string{"reviews"},
+			},
+			valid: true,
+		},
+		{
+			name:   "mutual without certificates",
+			policy: TrafficPolicy().TLS(v1beta1.TLSmodeMutual),
+			tls:    &v1beta1.TLSSettings{Mode: v1beta1.TLSmodeMutual},
+		},
+		{
+			name:   "secret and files",
+			policy: TrafficPolicy().MutualTLS("/etc/certs/cert.pem", "/etc/certs/key.pem", "").CredentialName("reviews-client"),
+			tls: &v1beta1.TLSSettings{
+				Mode:              v1beta1.TLSmodeMutual,
+				ClientCertificate: stringPtr("/etc/certs/cert.pem"),
+				PrivateKey:        stringPtr("/etc/certs/key.pem"),
+				CredentialName:    stringPtr("reviews-client"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr, err := NewDestinationRule("default", "reviews", "reviews.default.svc.cluster.local").
+				TrafficPolicy(tt.policy).
+				Build()
+			if valid := err == nil; valid != tt.valid {
+				t.Fatalf("expected the DestinationRule to be valid: %t, got %v", tt.valid, err)
+			}
+			if !reflect.DeepEqual(tt.policy.policy.TLS, tt.tls) {
+				t.Errorf("expected %+v, got %+v", tt.tls, tt.policy.policy.TLS)
+			}
+			if err == nil && !reflect.DeepEqual(dr.Spec.TrafficPolicy.TLS, tt.tls) {
+				t.Errorf("expected %+v, got %+v", tt.tls, dr.Spec.TrafficPolicy.TLS)
+			}
+		})
+	}
+}
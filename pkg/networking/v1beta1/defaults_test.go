@@ -0,0 +1,197 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestDefaults(t *testing.T) {
+	roundRobin := SimpleLBRoundRobin
+	random := SimpleLBRandom
+	meshExternal := MeshExternal
+	meshInternal := MeshInternal
+	none := NONE
+	dns := DNS
+	defaultRoute := HTTPRoute{
+		Route:   []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}},
+		Retries: &HTTPRetry{Attempts: DefaultRetryAttempts, RetryOn: stringPtr(DefaultRetryOn)},
+	}
+
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected runtime.Object
+	}{
+		{
+			name: "virtual service",
+			obj: &VirtualService{Spec: VirtualServiceSpec{HTTP: []HTTPRoute{
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}},
+				{Redirect: &HTTPRedirect{URI: stringPtr("/v2")}},
+			}}},
+			expected: &VirtualService{Spec: VirtualServiceSpec{
+				ExportTo: []string{DefaultExportTo},
+				HTTP: []HTTPRoute{
+					defaultRoute,
+					{Redirect: &HTTPRedirect{URI: stringPtr("/v2"), RedirectCode: uint32Ptr(DefaultRedirectCode)}},
+				},
+			}},
+		},
+		{
+			name: "virtual service with settings",
+			obj: &VirtualService{Spec: VirtualServiceSpec{
+				ExportTo: []string{"."},
+				HTTP: []HTTPRoute{
+					{
+						Route:   []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}},
+						Retries: &HTTPRetry{Attempts: 5},
+					},
+					{
+						Route:   []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}},
+						Retries: &HTTPRetry{RetryOn: stringPtr("5xx")},
+					},
+					{Redirect: &HTTPRedirect{URI: stringPtr("/v2"), RedirectCode: uint32Ptr(302)}},
+				},
+			}},
+			expected: &VirtualService{Spec: VirtualServiceSpec{
+				ExportTo: []string{"."},
+				HTTP: []HTTPRoute{
+					{
+						Route:   []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}},
+						Retries: &HTTPRetry{Attempts: 5, RetryOn: stringPtr(DefaultRetryOn)},
+					},
+					{
+						Route:   []*HTTPRouteDestination{{Destination: &Destination{Host: "ratings"}}},
+						Retries: &HTTPRetry{RetryOn: stringPtr("5xx")},
+					},
+					{Redirect: &HTTPRedirect{URI: stringPtr("/v2"), RedirectCode: uint32Ptr(302)}},
+				},
+			}},
+		},
+		{
+			name: "virtual service list",
+			obj: &VirtualServiceList{Items: []VirtualService{{Spec: VirtualServiceSpec{HTTP: []HTTPRoute{
+				{Route: []*HTTPRouteDestination{{Destination: &Destination{Host: "reviews"}}}},
+			}}}}},
+			expected: &VirtualServiceList{Items: []VirtualService{{Spec: VirtualServiceSpec{
+				ExportTo: []string{DefaultExportTo},
+				HTTP:     []HTTPRoute{defaultRoute},
+			}}}},
+		},
+		{
+			name: "destination rule",
+			obj: &DestinationRule{Spec: DestinationRuleSpec{
+				TrafficPolicy: &TrafficPolicy{TrafficPolicyCommon: TrafficPolicyCommon{
+					LoadBalancer:     &LoadBalancerSettings{},
+					ConnectionPool:   &ConnectionPoolSettings{HTTP: &HTTPSettings{HTTP2MaxRequests: int32Ptr(100)}},
+					OutlierDetection: &OutlierDetection{},
+					TLS:              &TLSSettings{},
+				}},
+				Subsets: []Subset{{
+					Name: "v1",
+					TrafficPolicy: &TrafficPolicy{PortLevelSettings: []PortTrafficPolicy{{
+						TrafficPolicyCommon: TrafficPolicyCommon{LoadBalancer: &LoadBalancerSettings{Simple: &random}},
+					}}},
+				}},
+			}},
+			expected: &DestinationRule{Spec: DestinationRuleSpec{
+				ExportTo: []string{DefaultExportTo},
+				TrafficPolicy: &TrafficPolicy{TrafficPolicyCommon: TrafficPolicyCommon{
+					LoadBalancer: &LoadBalancerSettings{Simple: &roundRobin},
+					ConnectionPool: &ConnectionPoolSettings{HTTP: &HTTPSettings{
+						HTTP1MaxPendingRequests: int32Ptr(DefaultHTTP1MaxPendingRequests),
+						HTTP2MaxRequests:        int32Ptr(100),
+						MaxRetries:              int32Ptr(DefaultMaxRetries),
+					}},
+					OutlierDetection: &OutlierDetection{
+						ConsecutiveErrors:  DefaultOutlierConsecutiveErrors,
+						Interval:           v1alpha1.NewDuration(DefaultOutlierInterval),
+						BaseEjectionTime:   v1alpha1.NewDuration(DefaultOutlierBaseEjectionTime),
+						MaxEjectionPercent: int32Ptr(DefaultOutlierMaxEjectionPercent),
+					},
+					TLS: &TLSSettings{Mode: TLSmodeDisable},
+				}},
+				Subsets: []Subset{{
+					Name: "v1",
+					TrafficPolicy: &TrafficPolicy{PortLevelSettings: []PortTrafficPolicy{{
+						TrafficPolicyCommon: TrafficPolicyCommon{LoadBalancer: &LoadBalancerSettings{Simple: &random}},
+					}}},
+				}},
+			}},
+		},
+		{
+			name: "consistent hash",
+			obj: &DestinationRule{Spec: DestinationRuleSpec{
+				TrafficPolicy: &TrafficPolicy{TrafficPolicyCommon: TrafficPolicyCommon{
+					LoadBalancer:     &LoadBalancerSettings{ConsistentHash: &ConsistentHashLB{HTTPHeaderName: stringPtr("x-user")}},
+					OutlierDetection: &OutlierDetection{ConsecutiveErrors: 1, Interval: v1alpha1.NewDuration(time.Minute)},
+				}},
+			}},
+			expected: &DestinationRule{Spec: DestinationRuleSpec{
+				ExportTo: []string{DefaultExportTo},
+				TrafficPolicy: &TrafficPolicy{TrafficPolicyCommon: TrafficPolicyCommon{
+					LoadBalancer: &LoadBalancerSettings{ConsistentHash: &ConsistentHashLB{HTTPHeaderName: stringPtr("x-user")}},
+					OutlierDetection: &OutlierDetection{
+						ConsecutiveErrors:  1,
+						Interval:           v1alpha1.NewDuration(time.Minute),
+						BaseEjectionTime:   v1alpha1.NewDuration(DefaultOutlierBaseEjectionTime),
+						MaxEjectionPercent: int32Ptr(DefaultOutlierMaxEjectionPercent),
+					},
+				}},
+			}},
+		},
+		{
+			name:     "service entry",
+			obj:      &ServiceEntry{Spec: ServiceEntrySpec{Hosts: []string{"example.com"}}},
+			expected: &ServiceEntry{Spec: ServiceEntrySpec{Hosts: []string{"example.com"}, Location: &meshExternal, Resolution: &none, ExportTo: []string{DefaultExportTo}}},
+		},
+		{
+			name:     "service entry with settings",
+			obj:      &ServiceEntry{Spec: ServiceEntrySpec{Location: &meshInternal, Resolution: &dns, ExportTo: []string{"."}}},
+			expected: &ServiceEntry{Spec: ServiceEntrySpec{Location: &meshInternal, Resolution: &dns, ExportTo: []string{"."}}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme.Default(tt.obj)
+			if !equality.Semantic.DeepEqual(tt.obj, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, tt.obj)
+			}
+		})
+	}
+}
@@ -16,10 +16,13 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io,shortName=dr
 // DestinationRule
 type DestinationRule struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -223,6 +226,7 @@ type Subset struct {
 
 	// Labels apply a filter over the endpoints of a service in the
 	// service registry. See route rules for examples of usage.
+	// +optional
 	Labels map[string]string `json:"labels"`
 
 	// Traffic policies that apply to this subset. Subsets inherit the
@@ -285,6 +289,7 @@ type LoadBalancerSettings struct {
 	ConsistentHash *ConsistentHashLB `json:"consistentHash,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=DEFAULT;DO_NOT_UPGRADE;UPGRADE
 type H2UpgradePolicy string
 
 const (
@@ -301,6 +306,7 @@ const (
 )
 
 // Standard load balancing algorithms that require no tuning.
+// +kubebuilder:validation:Enum=ROUND_ROBIN;LEAST_CONN;RANDOM;PASSTHROUGH
 type SimpleLB string
 
 const (
@@ -362,7 +368,7 @@ type HTTPCookie struct {
 	Path *string `json:"path,omitempty"`
 
 	// REQUIRED. Lifetime of the cookie.
-	TTL string `json:"ttl"`
+	TTL v1alpha1.Duration `json:"ttl"`
 }
 
 // Connection pool settings for an upstream host. The settings apply to
@@ -404,7 +410,7 @@ type TCPSettings struct {
 	MaxConnections *int32 `json:"maxConnections,omitempty"`
 
 	// TCP connection timeout.
-	ConnectTimeout *string `json:"connectTimeout,omitempty"`
+	ConnectTimeout *v1alpha1.Duration `json:"connectTimeout,omitempty"`
 
 	// If set then set SO_KEEPALIVE on the socket to enable TCP Keepalives.
 	TCPKeepalive *TCPKeepalive `json:"tcpKeepalive,omitempty"`
@@ -419,11 +425,11 @@ type TCPKeepalive struct {
 	// The time duration a connection needs to be idle before keep-alive
 	// probes start being sent. Default is to use the OS level configuration
 	// (unless overridden, Linux defaults to 7200s (ie 2 hours.)
-	Time *string `json:"time,omitempty"`
+	Time *v1alpha1.Duration `json:"time,omitempty"`
 	// The time duration between keep-alive probes.
 	// Default is to use the OS level configuration
 	// (unless overridden, Linux defaults to 75s.)
-	Interval *string `json:"interval,omitempty"`
+	Interval *v1alpha1.Duration `json:"interval,omitempty"`
 }
 
 // Settings applicable to HTTP1.1/HTTP2/GRPC connections.
@@ -445,7 +451,7 @@ type HTTPSettings struct {
 	// The idle timeout for upstream connection pool connections. The idle timeout is defined as the period in which there are no active requests.
 	// If not set, there is no idle timeout. When the idle timeout is reached the connection will be closed.
 	// Note that request based timeouts mean that HTTP/2 PINGs will not keep the connection alive. Applies to both HTTP1.1 and HTTP2 connections.
-	IdleTimeout *string `json:"idleTimeout,omitempty"`
+	IdleTimeout *v1alpha1.Duration `json:"idleTimeout,omitempty"`
 
 	// Specify if http1.1 connection should be upgraded to http2 for the associated destination.
 	H2UpgradePolicy *H2UpgradePolicy `json:"h2UpgradePolicy,omitempty"`
@@ -496,14 +502,14 @@ type OutlierDetection struct {
 
 	// Time interval between ejection sweep analysis. format:
 	// 1h/1m/1s/1ms. MUST BE >=1ms. Default is 10s.
-	Interval *string `json:"interval,omitempty"`
+	Interval *v1alpha1.Duration `json:"interval,omitempty"`
 
 	// Minimum ejection duration. A host will remain ejected for a period
 	// equal to the product of minimum ejection duration and the number of
 	// times the host has been ejected. This technique allows the system to
 	// automatically increase the ejection period for unhealthy upstream
 	// servers. format: 1h/1m/1s/1ms. MUST BE >=1ms. Default is 30s.
-	BaseEjectionTime *string `json:"baseEjectionTime,omitempty"`
+	BaseEjectionTime *v1alpha1.Duration `json:"baseEjectionTime,omitempty"`
 
 	// Maximum % of hosts in the load balancing pool for the upstream
 	// service that can be ejected. Defaults to 10%.
@@ -600,9 +606,18 @@ type TLSSettings struct {
 
 	// SNI string to present to the server during TLS handshake.
 	SNI *string `json:"sni,omitempty"`
+
+	// The name of the secret that holds the TLS certs for the client
+	// including the CA certificates. The secret must exist in the same
+	// namespace as the proxy using the certificates. Only one of the client
+	// certificates and CA certificate or credentialName can be specified.
+	// NOTE: This field is currently applicable only at gateways. Sidecars
+	// will continue to use the certificate paths.
+	CredentialName *string `json:"credentialName,omitempty"`
 }
 
 // TLS connection mode
+// +kubebuilder:validation:Enum=DISABLE;SIMPLE;MUTUAL;ISTIO_MUTUAL
 type TLSmode string
 
 const (
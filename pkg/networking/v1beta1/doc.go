@@ -13,6 +13,8 @@
 // limitations under the License.
 
 // +k8s:deepcopy-gen=package
+// +k8s:openapi-gen=true
+// +k8s:defaulter-gen=TypeMeta
 // +groupName=networking.istio.io
 
 package v1beta1
@@ -0,0 +1,104 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// matchRequest reports whether every condition set in the match holds for the
+// request. The path is expected without its query string.
+func matchRequest(match *v1beta1.HTTPMatchRequest, request *Request, path string) bool {
+	if match.URI != nil {
+		ignoreCase := match.IgnoreURICase != nil && *match.IgnoreURICase
+		if !matchString(match.URI, path, true, ignoreCase) {
+			return false
+		}
+	}
+	if match.Scheme != nil && !matchString(match.Scheme, request.Scheme, request.Scheme != "", false) {
+		return false
+	}
+	if match.Method != nil && !matchString(match.Method, request.Method, request.Method != "", false) {
+		return false
+	}
+	if match.Authority != nil && !matchString(match.Authority, request.Authority, request.Authority != "", false) {
+		return false
+	}
+
+	for name, stringMatch := range match.Headers {
+		values, ok := request.Headers[http.CanonicalHeaderKey(name)]
+		if !ok || len(values) == 0 {
+			return false
+		}
+		if !matchString(&stringMatch, values[0], true, false) {
+			return false
+		}
+	}
+	for name, stringMatch := range match.QueryParams {
+		values, ok := request.QueryParams[name]
+		if !ok {
+			return false
+		}
+		value := ""
+		if len(values) > 0 {
+			value = values[0]
+		}
+		if stringMatch != nil && !matchString(stringMatch, value, true, false) {
+			return false
+		}
+	}
+
+	if match.Port != nil && *match.Port != request.Port {
+		return false
+	}
+	for name, value := range match.SourceLabels {
+		if label, ok := request.SourceLabels[name]; !ok || label != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchString implements StringMatch semantics. A StringMatch with no field
+// set matches any present value. Regular expressions have to match the whole
+// value, the same way RE2 matching of the proxy works. ignoreCase only applies
+// to exact and prefix matches, like ignoreUriCase does in Istio.
+func matchString(match *v1alpha1.StringMatch, value string, present, ignoreCase bool) bool {
+	switch {
+	case match.Exact != "":
+		if ignoreCase {
+			return strings.EqualFold(value, match.Exact)
+		}
+		return value == match.Exact
+	case match.Prefix != "":
+		if ignoreCase {
+			return strings.HasPrefix(strings.ToLower(value), strings.ToLower(match.Prefix))
+		}
+		return strings.HasPrefix(value, match.Prefix)
+	case match.Suffix != "":
+		return strings.HasSuffix(value, match.Suffix)
+	case match.Regex != "":
+		re, err := regexp.Compile("^(?:" + match.Regex + ")$")
+		return err == nil && re.MatchString(value)
+	default:
+		return present
+	}
+}
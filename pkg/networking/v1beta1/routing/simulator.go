@@ -0,0 +1,396 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routing simulates how the proxies route HTTP requests according to
+// VirtualService resources, without access to a cluster.
+package routing
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+// MeshGateway is the reserved gateway name of the sidecars in the mesh.
+const MeshGateway = "mesh"
+
+var (
+	// ErrNoVirtualService is returned when no VirtualService is bound to the
+	// authority and gateway of the request.
+	ErrNoVirtualService = errors.New("no virtual service matches the host and gateway of the request")
+	// ErrNoRoute is returned when the VirtualService of the request has no
+	// HTTP route matching it.
+	ErrNoRoute = errors.New("no http route matches the request")
+)
+
+// Request describes an HTTP request entering a proxy.
+type Request struct {
+	// Authority is the host of the request, optionally followed by a port.
+	Authority string
+	// Path may contain a query string, which is matched against QueryParams
+	// only when those are not given explicitly.
+	Path        string
+	Method      string
+	Scheme      string
+	Headers     http.Header
+	QueryParams url.Values
+	Port        uint32
+	// SourceLabels are the labels of the workload sending the request.
+	SourceLabels map[string]string
+	// Namespace is the namespace of the workload sending the request, which
+	// sidecars resolve unqualified authorities like "reviews" in. They are
+	// resolved in the namespace of each VirtualService when empty.
+	Namespace string
+	// Gateway is the "namespace/name" of the gateway receiving the request,
+	// or MeshGateway (the default) for sidecars.
+	Gateway string
+}
+
+// Result is the route a request was matched to along with the settings which
+// apply to it.
+type Result struct {
+	VirtualService *v1beta1.VirtualService
+	Route          *v1beta1.HTTPRoute
+	// RouteIndex is the index of Route within the http routes of the spec.
+	RouteIndex int
+	// MatchIndex is the index of the first matching HTTPMatchRequest of the
+	// route, or -1 if the route matches every request.
+	MatchIndex int
+	// Destination is the destination receiving the request, the one with the
+	// highest weight when traffic is split. It is nil for redirects.
+	Destination  *v1beta1.Destination
+	Destinations []*v1beta1.HTTPRouteDestination
+	Redirect     *v1beta1.HTTPRedirect
+	Rewrite      *v1beta1.HTTPRewrite
+	// Path and Authority are those sent upstream, or the location of the
+	// redirect, after applying Rewrite or Redirect.
+	Path      string
+	Authority string
+	// Headers holds the header operations of the route merged with the ones
+	// of Destination, which take precedence.
+	Headers *v1beta1.Headers
+	Timeout *v1alpha1.Duration
+	Retries *v1beta1.HTTPRetry
+}
+
+// Simulator routes requests according to a set of virtual services.
+type Simulator struct {
+	// ClusterDomain is the DNS domain of the cluster which short hosts are
+	// expanded with, host.DefaultClusterDomain if empty.
+	ClusterDomain   string
+	VirtualServices []v1beta1.VirtualService
+}
+
+// Simulate routes the request with the virtual services, using the default
+// cluster domain.
+func Simulate(virtualServices []v1beta1.VirtualService, request Request) (*Result, error) {
+	s := Simulator{VirtualServices: virtualServices}
+	return s.Simulate(request)
+}
+
+// Simulate routes the request using the VirtualService bound to its authority
+// and gateway. The most specific host wins when several VirtualServices claim
+// the authority, the oldest one when they are equally specific. Within it the
+// first http route with a matching HTTPMatchRequest is selected.
+//
+// Hosts of virtual services are expanded to the fully qualified names of the
+// services in their namespace, "reviews" in namespace "default" to
+// "reviews.default.svc.cluster.local". Sidecars also route the shorter names
+// resolved by the DNS search paths of pods to the services: "reviews" from
+// the same namespace, "reviews.default" and "reviews.default.svc".
+func (s *Simulator) Simulate(request Request) (*Result, error) {
+	vs := s.selectVirtualService(&request)
+	if vs == nil {
+		return nil, ErrNoVirtualService
+	}
+
+	path, rawQuery := splitQuery(request.Path)
+	if request.QueryParams == nil {
+		request.QueryParams, _ = url.ParseQuery(rawQuery)
+	}
+
+	for i := range vs.Spec.HTTP {
+		route := &vs.Spec.HTTP[i]
+		if len(route.Match) == 0 {
+			return newResult(vs, route, i, -1, &request, path), nil
+		}
+		for j, match := range route.Match {
+			if match != nil && matchRequest(match, &request, path) {
+				return newResult(vs, route, i, j, &request, path), nil
+			}
+		}
+	}
+
+	return nil, ErrNoRoute
+}
+
+func newResult(vs *v1beta1.VirtualService, route *v1beta1.HTTPRoute, routeIndex, matchIndex int, request *Request, path string) *Result {
+	result := &Result{
+		VirtualService: vs,
+		Route:          route,
+		RouteIndex:     routeIndex,
+		MatchIndex:     matchIndex,
+		Destinations:   route.Route,
+		Redirect:       route.Redirect,
+		Rewrite:        route.Rewrite,
+		Path:           request.Path,
+		Authority:      request.Authority,
+		Timeout:        route.Timeout,
+		Retries:        route.Retries,
+	}
+
+	var match *v1beta1.HTTPMatchRequest
+	if matchIndex >= 0 {
+		match = route.Match[matchIndex]
+	}
+
+	if route.Redirect != nil {
+		if route.Redirect.URI != nil {
+			result.Path = *route.Redirect.URI
+		}
+		if route.Redirect.Authority != nil {
+			result.Authority = *route.Redirect.Authority
+		}
+		result.Headers = route.Headers
+		return result
+	}
+
+	if route.Rewrite != nil {
+		if route.Rewrite.URI != nil {
+			result.Path = rewritePath(match, request.Path, path, *route.Rewrite.URI)
+		}
+		if route.Rewrite.Authority != nil {
+			result.Authority = *route.Rewrite.Authority
+		}
+	}
+
+	var destination *v1beta1.HTTPRouteDestination
+	for _, candidate := range route.Route {
+		if candidate == nil {
+			continue
+		}
+		if destination == nil || weight(candidate) > weight(destination) {
+			destination = candidate
+		}
+	}
+	if destination != nil {
+		result.Destination = destination.Destination
+		result.Headers = mergeHeaders(route.Headers, destination.Headers)
+	} else {
+		result.Headers = route.Headers
+	}
+
+	return result
+}
+
+// rewritePath replaces the part of the path matched by the uri of the match
+// with the rewrite, the way prefix rewrites of the proxy work. Regex matches
+// have the whole path replaced.
+func rewritePath(match *v1beta1.HTTPMatchRequest, fullPath, path, rewrite string) string {
+	prefix := "/"
+	if match != nil && match.URI != nil {
+		switch {
+		case match.URI.Exact != "":
+			prefix = match.URI.Exact
+		case match.URI.Prefix != "":
+			prefix = match.URI.Prefix
+		case match.URI.Regex != "":
+			return rewrite + fullPath[len(path):]
+		}
+	}
+	if len(prefix) > len(fullPath) {
+		return rewrite
+	}
+
+	return rewrite + fullPath[len(prefix):]
+}
+
+func weight(destination *v1beta1.HTTPRouteDestination) int {
+	if destination.Weight == nil {
+		return 100
+	}
+
+	return *destination.Weight
+}
+
+func mergeHeaders(route, destination *v1beta1.Headers) *v1beta1.Headers {
+	if route == nil {
+		return destination
+	}
+	if destination == nil {
+		return route
+	}
+
+	return &v1beta1.Headers{
+		Request:  mergeHeaderOperations(route.Request, destination.Request),
+		Response: mergeHeaderOperations(route.Response, destination.Response),
+	}
+}
+
+func mergeHeaderOperations(route, destination *v1beta1.HeaderOperations) *v1beta1.HeaderOperations {
+	if route == nil {
+		return destination
+	}
+	if destination == nil {
+		return route
+	}
+
+	merged := &v1beta1.HeaderOperations{
+		Set: map[string]string{},
+		Add: map[string]string{},
+	}
+	for _, ops := range []*v1beta1.HeaderOperations{route, destination} {
+		for name, value := range ops.Set {
+			merged.Set[name] = value
+		}
+		for name, value := range ops.Add {
+			merged.Add[name] = value
+		}
+		merged.Remove = append(merged.Remove, ops.Remove...)
+	}
+
+	return merged
+}
+
+// selectVirtualService returns the VirtualService with the most specific host
+// matching the authority among the ones bound to the gateway of the request.
+func (s *Simulator) selectVirtualService(request *Request) *v1beta1.VirtualService {
+	hostname := strings.ToLower(stripPort(request.Authority))
+	gateway := request.Gateway
+	if gateway == "" {
+		gateway = MeshGateway
+	}
+
+	type candidate struct {
+		vs          *v1beta1.VirtualService
+		specificity int
+	}
+	var candidates []candidate
+	for i := range s.VirtualServices {
+		vs := &s.VirtualServices[i]
+		if !boundToGateway(vs, gateway) {
+			continue
+		}
+		namespace := request.Namespace
+		if namespace == "" {
+			namespace = vs.Namespace
+		}
+		authorities := s.authorities(hostname, gateway, namespace)
+		best := -1
+		for _, vsHost := range vs.Spec.Hosts {
+			name := host.NewName(vsHost).FQDN(vs.Namespace, s.ClusterDomain)
+			for _, authority := range authorities {
+				if specificity := name.Specificity(authority); specificity > best {
+					best = specificity
+				}
+			}
+		}
+		if best >= 0 {
+			candidates = append(candidates, candidate{vs, best})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.specificity != b.specificity {
+			return a.specificity > b.specificity
+		}
+		if !a.vs.CreationTimestamp.Equal(&b.vs.CreationTimestamp) {
+			return a.vs.CreationTimestamp.Before(&b.vs.CreationTimestamp)
+		}
+		if a.vs.Namespace != b.vs.Namespace {
+			return a.vs.Namespace < b.vs.Namespace
+		}
+		return a.vs.Name < b.vs.Name
+	})
+
+	return candidates[0].vs
+}
+
+// authorities returns the names the proxy of the gateway routes the host name
+// of the request to. Sidecars complete the names Kubernetes pods resolve with
+// their DNS search paths to the fully qualified names of services, resolving
+// unqualified names in the namespace.
+func (s *Simulator) authorities(hostname, gateway, namespace string) []string {
+	authorities := []string{hostname}
+	if gateway != MeshGateway {
+		return authorities
+	}
+
+	domain := s.ClusterDomain
+	if domain == "" {
+		domain = host.DefaultClusterDomain
+	}
+	switch strings.Count(hostname, ".") {
+	case 0:
+		authorities = append(authorities, hostname+"."+namespace+".svc."+domain)
+	case 1:
+		authorities = append(authorities, hostname+".svc."+domain)
+	case 2:
+		if strings.HasSuffix(hostname, ".svc") {
+			authorities = append(authorities, hostname+"."+domain)
+		}
+	}
+
+	return authorities
+}
+
+func boundToGateway(vs *v1beta1.VirtualService, gateway string) bool {
+	gateways := vs.Spec.Gateways
+	if len(gateways) == 0 {
+		gateways = []string{MeshGateway}
+	}
+
+	for _, name := range gateways {
+		if name == MeshGateway || gateway == MeshGateway {
+			if name == gateway {
+				return true
+			}
+			continue
+		}
+		if !strings.Contains(name, "/") {
+			name = vs.Namespace + "/" + name
+		}
+		if name == gateway || (!strings.Contains(gateway, "/") && strings.HasSuffix(name, "/"+gateway)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stripPort(authority string) string {
+	if i := strings.LastIndex(authority, ":"); i >= 0 && !strings.Contains(authority[i:], "]") {
+		return authority[:i]
+	}
+
+	return authority
+}
+
+func splitQuery(path string) (string, string) {
+	if i := strings.Index(path, "?"); i >= 0 {
+		return path[:i], path[i+1:]
+	}
+
+	return path, ""
+}
@@ -0,0 +1,308 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestValidateDestinationRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   v1beta1.DestinationRuleSpec
+		fields []string
+	}{
+		{
+			name: "subsets",
+			spec: v1beta1.DestinationRuleSpec{
+				Host: "reviews",
+				Subsets: []v1beta1.Subset{
+					{Name: "v1", Labels: map[string]string{"version": "v1"}},
+					{Name: "v2", Labels: map[string]string{"version": "v2"}},
+				},
+				ExportTo: []string{".", "*", "prod"},
+			},
+		},
+		{
+			name: "wildcard host",
+			spec: v1beta1.DestinationRuleSpec{Host: "*.example.com"},
+		},
+		{
+			name:   "no host",
+			fields: []string{"spec.host"},
+		},
+		{
+			name: "duplicate subsets",
+			spec: v1beta1.DestinationRuleSpec{
+				Host:    "reviews",
+				Subsets: []v1beta1.Subset{{Name: "v1"}, {Name: "v1"}},
+			},
+			fields: []string{"spec.subsets[1].name"},
+		},
+		{
+			name: "invalid subset names",
+			spec: v1beta1.DestinationRuleSpec{
+				Host:    "reviews",
+				Subsets: []v1beta1.Subset{{}, {Name: "V_1"}},
+			},
+			fields: []string{"spec.subsets[0].name", "spec.subsets[1].name"},
+		},
+		{
+			name: "invalid export namespace",
+			spec: v1beta1.DestinationRuleSpec{
+				Host:     "reviews",
+				ExportTo: []string{"~"},
+			},
+			fields: []string{"spec.exportTo[0]"},
+		},
+		{
+			name: "subset traffic policy",
+			spec: v1beta1.DestinationRuleSpec{
+				Host: "reviews",
+				Subsets: []v1beta1.Subset{{
+					Name:          "v1",
+					TrafficPolicy: &v1beta1.TrafficPolicy{TrafficPolicyCommon: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{}}},
+				}},
+			},
+			fields: []string{"spec.subsets[0].trafficPolicy.loadBalancer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := invalidFields(ValidateDestinationRule(&v1beta1.DestinationRule{Spec: tt.spec}))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateTrafficPolicy(t *testing.T) {
+	roundRobin := v1beta1.SimpleLBRoundRobin
+	unknownLB := v1beta1.SimpleLB("FASTEST")
+	upgrade := v1beta1.H2UpgradePolicy("ALWAYS")
+
+	tests := []struct {
+		name   string
+		policy v1beta1.TrafficPolicyCommon
+		fields []string
+	}{
+		{
+			name:   "simple load balancer",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{Simple: &roundRobin}},
+		},
+		{
+			name:   "unknown load balancer",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{Simple: &unknownLB}},
+			fields: []string{"spec.trafficPolicy.loadBalancer.simple"},
+		},
+		{
+			name: "simple and consistent hash",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{
+				Simple:         &roundRobin,
+				ConsistentHash: &v1beta1.ConsistentHashLB{HTTPHeaderName: stringPtr("x-user")},
+			}},
+			fields: []string{"spec.trafficPolicy.loadBalancer"},
+		},
+		{
+			name: "consistent hash on a cookie",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{
+				ConsistentHash: &v1beta1.ConsistentHashLB{HTTPCookie: &v1beta1.HTTPCookie{Name: "user", TTL: v1alpha1.Duration{Duration: time.Hour}}},
+			}},
+		},
+		{
+			name: "consistent hash on a cookie without a ttl",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{
+				ConsistentHash: &v1beta1.ConsistentHashLB{HTTPCookie: &v1beta1.HTTPCookie{}},
+			}},
+			fields: []string{"spec.trafficPolicy.loadBalancer.consistentHash.httpCookie.name", "spec.trafficPolicy.loadBalancer.consistentHash.httpCookie.ttl"},
+		},
+		{
+			name: "consistent hash on two keys",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{
+				ConsistentHash: &v1beta1.ConsistentHashLB{HTTPHeaderName: stringPtr("x-user"), UseSourceIP: boolPtr(true)},
+			}},
+			fields: []string{"spec.trafficPolicy.loadBalancer.consistentHash"},
+		},
+		{
+			name: "consistent hash without a key",
+			policy: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{
+				ConsistentHash: &v1beta1.ConsistentHashLB{UseSourceIP: boolPtr(false)},
+			}},
+			fields: []string{"spec.trafficPolicy.loadBalancer.consistentHash"},
+		},
+		{
+			name:   "empty connection pool",
+			policy: v1beta1.TrafficPolicyCommon{ConnectionPool: &v1beta1.ConnectionPoolSettings{}},
+			fields: []string{"spec.trafficPolicy.connectionPool"},
+		},
+		{
+			name: "connection pool limits",
+			policy: v1beta1.TrafficPolicyCommon{ConnectionPool: &v1beta1.ConnectionPoolSettings{
+				TCP: &v1beta1.TCPSettings{MaxConnections: int32Ptr(-1), ConnectTimeout: v1alpha1.NewDuration(time.Microsecond)},
+				HTTP: &v1beta1.HTTPSettings{
+					HTTP2MaxRequests: int32Ptr(100),
+					MaxRetries:       int32Ptr(-3),
+					H2UpgradePolicy:  &upgrade,
+				},
+			}},
+			fields: []string{
+				"spec.trafficPolicy.connectionPool.tcp.maxConnections",
+				"spec.trafficPolicy.connectionPool.tcp.connectTimeout",
+				"spec.trafficPolicy.connectionPool.http.maxRetries",
+				"spec.trafficPolicy.connectionPool.http.h2UpgradePolicy",
+			},
+		},
+		{
+			name: "outlier detection",
+			policy: v1beta1.TrafficPolicyCommon{OutlierDetection: &v1beta1.OutlierDetection{
+				ConsecutiveErrors:  5,
+				Interval:           v1alpha1.NewDuration(10 * time.Second),
+				BaseEjectionTime:   v1alpha1.NewDuration(30 * time.Second),
+				MaxEjectionPercent: int32Ptr(50),
+			}},
+		},
+		{
+			name: "outlier detection percentages",
+			policy: v1beta1.TrafficPolicyCommon{OutlierDetection: &v1beta1.OutlierDetection{
+				ConsecutiveErrors:  -1,
+				MaxEjectionPercent: int32Ptr(101),
+				MinHealthPercent:   int32Ptr(-1),
+			}},
+			fields: []string{
+				"spec.trafficPolicy.outlierDetection.consecutiveErrors",
+				"spec.trafficPolicy.outlierDetection.maxEjectionPercent",
+				"spec.trafficPolicy.outlierDetection.minHealthPercent",
+			},
+		},
+		{
+			name:   "istio mutual TLS",
+			policy: v1beta1.TrafficPolicyCommon{TLS: &v1beta1.TLSSettings{Mode: v1beta1.TLSmodeIstioMutual}},
+		},
+		{
+			name:   "unknown TLS mode",
+			policy: v1beta1.TrafficPolicyCommon{TLS: &v1beta1.TLSSettings{Mode: "STRICT"}},
+			fields: []string{"spec.trafficPolicy.tls.mode"},
+		},
+		{
+			name:   "mutual TLS without certificates",
+			policy: v1beta1.TrafficPolicyCommon{TLS: &v1beta1.TLSSettings{Mode: v1beta1.TLSmodeMutual}},
+			fields: []string{"spec.trafficPolicy.tls.clientCertificate", "spec.trafficPolicy.tls.privateKey"},
+		},
+		{
+			name: "mutual TLS with a secret and files",
+			policy: v1beta1.TrafficPolicyCommon{TLS: &v1beta1.TLSSettings{
+				Mode:           v1beta1.TLSmodeMutual,
+				CredentialName: stringPtr("reviews-client"),
+				CaCertificates: stringPtr("/etc/certs/ca.pem"),
+			}},
+			fields: []string{"spec.trafficPolicy.tls.caCertificates"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := &v1beta1.DestinationRule{
+				Spec: v1beta1.DestinationRuleSpec{
+					Host:          "reviews",
+					TrafficPolicy: &v1beta1.TrafficPolicy{TrafficPolicyCommon: tt.policy},
+				},
+			}
+			fields := invalidFields(ValidateDestinationRule(dr))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidatePortLevelSettings(t *testing.T) {
+	roundRobin := v1beta1.SimpleLBRoundRobin
+
+	tests := []struct {
+		name     string
+		settings []v1beta1.PortTrafficPolicy
+		fields   []string
+	}{
+		{
+			name: "distinct ports",
+			settings: []v1beta1.PortTrafficPolicy{
+				{Port: &v1beta1.PortSelector{Number: 80}},
+				{Port: &v1beta1.PortSelector{Number: 443}},
+			},
+		},
+		{
+			name: "duplicate ports",
+			settings: []v1beta1.PortTrafficPolicy{
+				{Port: &v1beta1.PortSelector{Number: 80}},
+				{Port: &v1beta1.PortSelector{Number: 80}},
+			},
+			fields: []string{"spec.trafficPolicy.portLevelSettings[1].port.number"},
+		},
+		{
+			name: "no port",
+			settings: []v1beta1.PortTrafficPolicy{{
+				TrafficPolicyCommon: v1beta1.TrafficPolicyCommon{LoadBalancer: &v1beta1.LoadBalancerSettings{Simple: &roundRobin}},
+			}},
+			fields: []string{"spec.trafficPolicy.portLevelSettings[0].port"},
+		},
+		{
+			name: "invalid port and settings",
+			settings: []v1beta1.PortTrafficPolicy{{
+				Port:                &v1beta1.PortSelector{Number: 0},
+				TrafficPolicyCommon: v1beta1.TrafficPolicyCommon{ConnectionPool: &v1beta1.ConnectionPoolSettings{}},
+			}},
+			fields: []string{"spec.trafficPolicy.portLevelSettings[0].port.number", "spec.trafficPolicy.portLevelSettings[0].connectionPool"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dr := &v1beta1.DestinationRule{
+				Spec: v1beta1.DestinationRuleSpec{
+					Host:          "reviews",
+					TrafficPolicy: &v1beta1.TrafficPolicy{PortLevelSettings: tt.settings},
+				},
+			}
+			fields := invalidFields(ValidateDestinationRule(dr))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"net"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/host"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+var (
+	supportedServiceEntryLocations = sets.NewString(
+		string(v1beta1.MeshExternal),
+		string(v1beta1.MeshInternal),
+	)
+
+	supportedServiceEntryResolutions = sets.NewString(
+		string(v1beta1.NONE),
+		string(v1beta1.STATIC),
+		string(v1beta1.DNS),
+	)
+)
+
+// ValidateServiceEntry validates the spec of a ServiceEntry.
+func ValidateServiceEntry(se *v1beta1.ServiceEntry) field.ErrorList {
+	return ValidateServiceEntrySpec(&se.Spec, field.NewPath("spec"))
+}
+
+// ValidateServiceEntrySpec validates a ServiceEntrySpec. Endpoints are
+// checked against the resolution: they are forbidden for NONE, required and
+// must be IP addresses for STATIC, and required for DNS when a host is a
+// wildcard.
+func ValidateServiceEntrySpec(spec *v1beta1.ServiceEntrySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Hosts) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hosts"), "at least one host is required"))
+	}
+	hasWildcardHost := false
+	for i, name := range spec.Hosts {
+		if name == "*" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hosts").Index(i), name, "must not be a wildcard"))
+			continue
+		}
+		if host.NewName(name).IsWildcard() {
+			hasWildcardHost = true
+		}
+		allErrs = append(allErrs, commonvalidation.ValidateWildcardDomain(name, fldPath.Child("hosts").Index(i))...)
+	}
+
+	for i, address := range spec.Addresses {
+		allErrs = append(allErrs, commonvalidation.ValidateIPOrCIDR(address, fldPath.Child("addresses").Index(i))...)
+	}
+
+	if len(spec.Ports) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("ports"), "at least one port is required"))
+	}
+	portNames := sets.NewString()
+	portNumbers := sets.NewInt()
+	for i, port := range spec.Ports {
+		portPath := fldPath.Child("ports").Index(i)
+		if port == nil {
+			allErrs = append(allErrs, field.Required(portPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validatePort(port, portPath)...)
+		if port.Name != "" {
+			if portNames.Has(port.Name) {
+				allErrs = append(allErrs, field.Duplicate(portPath.Child("name"), port.Name))
+			}
+			portNames.Insert(port.Name)
+		}
+		if portNumbers.Has(port.Number) {
+			allErrs = append(allErrs, field.Duplicate(portPath.Child("number"), port.Number))
+		}
+		portNumbers.Insert(port.Number)
+	}
+
+	if spec.Location != nil && !supportedServiceEntryLocations.Has(string(*spec.Location)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("location"), *spec.Location, supportedServiceEntryLocations.List()))
+	}
+
+	resolution := v1beta1.NONE
+	if spec.Resolution != nil {
+		resolution = *spec.Resolution
+		if !supportedServiceEntryResolutions.Has(string(resolution)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resolution"), resolution, supportedServiceEntryResolutions.List()))
+		}
+	}
+
+	switch resolution {
+	case v1beta1.NONE:
+		if len(spec.Endpoints) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endpoints"), "endpoints must not be set when resolution is NONE"))
+		}
+	case v1beta1.STATIC:
+		if len(spec.Endpoints) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("endpoints"), "endpoints are required when resolution is STATIC"))
+		}
+	case v1beta1.DNS:
+		if len(spec.Endpoints) == 0 && hasWildcardHost {
+			allErrs = append(allErrs, field.Required(fldPath.Child("endpoints"), "endpoints are required when resolution is DNS and a host is a wildcard"))
+		}
+	}
+
+	for i, endpoint := range spec.Endpoints {
+		endpointPath := fldPath.Child("endpoints").Index(i)
+		if endpoint == nil {
+			allErrs = append(allErrs, field.Required(endpointPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateServiceEntryEndpoint(endpoint, resolution, portNames, endpointPath)...)
+	}
+
+	allErrs = append(allErrs, validateExportTo(spec.ExportTo, fldPath.Child("exportTo"))...)
+
+	return allErrs
+}
+
+func validateServiceEntryEndpoint(endpoint *v1beta1.ServiceEntryEndpoint, resolution v1beta1.ServiceEntryResolution, portNames sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if endpoint.Address == nil || *endpoint.Address == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("address"), ""))
+	} else {
+		address := *endpoint.Address
+		switch {
+		case strings.HasPrefix(address, "unix://"):
+			if resolution != v1beta1.STATIC {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("address"), address, "unix domain sockets require STATIC resolution"))
+			}
+		case net.ParseIP(address) != nil:
+		case resolution == v1beta1.STATIC:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("address"), address, "must be an IP address when resolution is STATIC"))
+		default:
+			for _, msg := range validation.IsDNS1123Subdomain(address) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("address"), address, msg))
+			}
+		}
+	}
+
+	for _, name := range sets.StringKeySet(endpoint.Ports).List() {
+		portPath := fldPath.Child("ports").Key(name)
+		if !portNames.Has(name) {
+			allErrs = append(allErrs, field.NotFound(portPath, name))
+		}
+		allErrs = append(allErrs, commonvalidation.ValidatePort(int64(endpoint.Ports[name]), portPath)...)
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(endpoint.Labels, fldPath.Child("labels"))...)
+
+	return allErrs
+}
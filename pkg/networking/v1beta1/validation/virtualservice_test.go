@@ -0,0 +1,300 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1"
+	"github.com/banzaicloud/istio-client-go/pkg/networking/v1beta1"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+func httpDestination(host string, weight int) *v1beta1.HTTPRouteDestination {
+	destination := &v1beta1.HTTPRouteDestination{Destination: &v1beta1.Destination{Host: host}}
+	if weight != 0 {
+		destination.Weight = intPtr(weight)
+	}
+
+	return destination
+}
+
+// virtualService returns a VirtualService routing reviews to itself, changed
+// by the function.
+func virtualService(change func(spec *v1beta1.VirtualServiceSpec)) *v1beta1.VirtualService {
+	vs := &v1beta1.VirtualService{
+		Spec: v1beta1.VirtualServiceSpec{
+			Hosts: []string{"reviews"},
+			HTTP: []v1beta1.HTTPRoute{{
+				Route: []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+			}},
+		},
+	}
+	if change != nil {
+		change(&vs.Spec)
+	}
+
+	return vs
+}
+
+func TestValidateVirtualServiceHosts(t *testing.T) {
+	tests := []struct {
+		name     string
+		hosts    []string
+		gateways []string
+		fields   []string
+	}{
+		{name: "short name", hosts: []string{"reviews"}},
+		{name: "wildcard domain", hosts: []string{"*.example.com"}},
+		{name: "IP address", hosts: []string{"10.0.0.1"}},
+		{name: "no hosts", fields: []string{"spec.hosts"}},
+		{name: "catch all on the mesh", hosts: []string{"*"}, fields: []string{"spec.hosts[0]"}},
+		{name: "catch all on an explicit mesh", hosts: []string{"*"}, gateways: []string{"ingress", MeshGateway}, fields: []string{"spec.hosts[0]"}},
+		{name: "catch all on a gateway", hosts: []string{"*"}, gateways: []string{"istio-system/ingress"}},
+		{name: "wildcard in the middle", hosts: []string{"api.*.com"}, fields: []string{"spec.hosts[0]"}},
+		{name: "invalid gateway namespace", hosts: []string{"reviews"}, gateways: []string{"Istio/ingress"}, fields: []string{"spec.gateways[0]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := virtualService(func(spec *v1beta1.VirtualServiceSpec) {
+				spec.Hosts = tt.hosts
+				spec.Gateways = tt.gateways
+			})
+			fields := invalidFields(ValidateVirtualService(vs))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateHTTPRoute(t *testing.T) {
+	tests := []struct {
+		name   string
+		route  v1beta1.HTTPRoute
+		fields []string
+	}{
+		{
+			name:  "single destination without a weight",
+			route: v1beta1.HTTPRoute{Route: []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)}},
+		},
+		{
+			name: "weights adding up to 100",
+			route: v1beta1.HTTPRoute{Route: []*v1beta1.HTTPRouteDestination{
+				httpDestination("reviews", 75), httpDestination("ratings", 25),
+			}},
+		},
+		{
+			name: "weights not adding up to 100",
+			route: v1beta1.HTTPRoute{Route: []*v1beta1.HTTPRouteDestination{
+				httpDestination("reviews", 50), httpDestination("ratings", 25),
+			}},
+			fields: []string{"spec.http[0].route"},
+		},
+		{
+			name: "weight above 100",
+			route: v1beta1.HTTPRoute{Route: []*v1beta1.HTTPRouteDestination{
+				httpDestination("reviews", 150), httpDestination("ratings", -50),
+			}},
+			fields: []string{"spec.http[0].route[0].weight", "spec.http[0].route[1].weight"},
+		},
+		{
+			name:   "no route or redirect",
+			fields: []string{"spec.http[0].route"},
+		},
+		{
+			name:  "redirect",
+			route: v1beta1.HTTPRoute{Redirect: &v1beta1.HTTPRedirect{URI: stringPtr("/v2"), RedirectCode: uint32Ptr(308)}},
+		},
+		{
+			name: "redirect with a route",
+			route: v1beta1.HTTPRoute{
+				Redirect: &v1beta1.HTTPRedirect{URI: stringPtr("/v2")},
+				Route:    []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+			},
+			fields: []string{"spec.http[0].route"},
+		},
+		{
+			name:   "redirect without a target",
+			route:  v1beta1.HTTPRoute{Redirect: &v1beta1.HTTPRedirect{RedirectCode: uint32Ptr(200)}},
+			fields: []string{"spec.http[0].redirect", "spec.http[0].redirect.redirectCode"},
+		},
+		{
+			name: "wildcard destination",
+			route: v1beta1.HTTPRoute{Route: []*v1beta1.HTTPRouteDestination{
+				httpDestination("*", 0),
+			}},
+			fields: []string{"spec.http[0].route[0].destination.host"},
+		},
+		{
+			name: "empty rewrite",
+			route: v1beta1.HTTPRoute{
+				Route:   []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				Rewrite: &v1beta1.HTTPRewrite{},
+			},
+			fields: []string{"spec.http[0].rewrite"},
+		},
+		{
+			name: "timeouts",
+			route: v1beta1.HTTPRoute{
+				Route:   []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				Timeout: v1alpha1.NewDuration(1500 * time.Microsecond),
+				Retries: &v1beta1.HTTPRetry{Attempts: 3, PerTryTimeout: v1alpha1.Duration{Duration: time.Second}},
+			},
+			fields: []string{"spec.http[0].timeout"},
+		},
+		{
+			name: "retry policies",
+			route: v1beta1.HTTPRoute{
+				Route:   []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				Retries: &v1beta1.HTTPRetry{Attempts: -1, RetryOn: stringPtr("5xx, reset,503,sometimes,600")},
+			},
+			fields: []string{"spec.http[0].retries.attempts", "spec.http[0].retries.retryOn", "spec.http[0].retries.retryOn"},
+		},
+		{
+			name: "fault injection",
+			route: v1beta1.HTTPRoute{
+				Route: []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				Fault: &v1beta1.HTTPFaultInjection{
+					Delay: &v1beta1.Delay{Percentage: &v1beta1.Percentage{Value: 10}},
+					Abort: &v1beta1.Abort{HTTPStatus: 100, Percentage: &v1beta1.Percentage{Value: 101}},
+				},
+			},
+			fields: []string{"spec.http[0].fault.delay.fixedDelay", "spec.http[0].fault.abort.httpStatus", "spec.http[0].fault.abort.percentage.value"},
+		},
+		{
+			name: "headers",
+			route: v1beta1.HTTPRoute{
+				Route: []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				Match: []*v1beta1.HTTPMatchRequest{{
+					Headers: map[string]v1alpha1.StringMatch{
+						"x-team":   {Exact: "blue"},
+						"X-Canary": {Exact: "true"},
+					},
+				}},
+			},
+			fields: []string{"spec.http[0].match[0].headers[X-Canary]"},
+		},
+		{
+			name: "cors max age",
+			route: v1beta1.HTTPRoute{
+				Route:      []*v1beta1.HTTPRouteDestination{httpDestination("reviews", 0)},
+				CorsPolicy: &v1beta1.CorsPolicy{MaxAge: v1alpha1.NewDuration(1500 * time.Millisecond)},
+			},
+			fields: []string{"spec.http[0].corsPolicy.maxAge"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := virtualService(func(spec *v1beta1.VirtualServiceSpec) {
+				spec.HTTP = []v1beta1.HTTPRoute{tt.route}
+			})
+			fields := invalidFields(ValidateVirtualService(vs))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateL4Routes(t *testing.T) {
+	destination := []*v1beta1.RouteDestination{{Destination: &v1beta1.Destination{Host: "mongo"}}}
+
+	tests := []struct {
+		name   string
+		spec   v1beta1.VirtualServiceSpec
+		fields []string
+	}{
+		{
+			name: "tls route",
+			spec: v1beta1.VirtualServiceSpec{TLS: []v1beta1.TLSRoute{{
+				Match: []v1beta1.TLSMatchAttributes{{SniHosts: []string{"*.example.com"}, Port: intPtr(443)}},
+				Route: destination,
+			}}},
+		},
+		{
+			name:   "tls route without matches",
+			spec:   v1beta1.VirtualServiceSpec{TLS: []v1beta1.TLSRoute{{Route: destination}}},
+			fields: []string{"spec.tls[0].match"},
+		},
+		{
+			name: "tls match without SNI hosts",
+			spec: v1beta1.VirtualServiceSpec{TLS: []v1beta1.TLSRoute{{
+				Match: []v1beta1.TLSMatchAttributes{{DestinationSubnets: []string{"10.0.0.0/33"}}},
+				Route: destination,
+			}}},
+			fields: []string{"spec.tls[0].match[0].sniHosts", "spec.tls[0].match[0].destinationSubnets[0]"},
+		},
+		{
+			name: "tcp route",
+			spec: v1beta1.VirtualServiceSpec{TCP: []v1beta1.TCPRoute{{
+				Match: []v1beta1.L4MatchAttributes{{Port: intPtr(27017), DestinationSubnets: []string{"10.0.0.1"}}},
+				Route: destination,
+			}}},
+		},
+		{
+			name: "tcp route without destinations",
+			spec: v1beta1.VirtualServiceSpec{TCP: []v1beta1.TCPRoute{{
+				Match: []v1beta1.L4MatchAttributes{{Port: intPtr(70000)}},
+			}}},
+			fields: []string{"spec.tcp[0].match[0].port", "spec.tcp[0].route"},
+		},
+		{
+			name: "tcp weights not adding up to 100",
+			spec: v1beta1.VirtualServiceSpec{TCP: []v1beta1.TCPRoute{{
+				Route: []*v1beta1.RouteDestination{
+					{Destination: &v1beta1.Destination{Host: "mongo"}, Weight: intPtr(50)},
+					{Destination: &v1beta1.Destination{Host: "mongo-replica"}, Weight: intPtr(40)},
+				},
+			}}},
+			fields: []string{"spec.tcp[0].route"},
+		},
+		{
+			name:   "no routes",
+			fields: []string{"spec"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			spec.Hosts = []string{"mongo.example.com"}
+			fields := invalidFields(ValidateVirtualService(&v1beta1.VirtualService{Spec: spec}))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;networking-istio-io,shortName=vs
 
 // Configuration affecting traffic routing. Here are a few terms useful to define
 // in the context of traffic routing.
@@ -236,7 +237,7 @@ type HTTPRoute struct {
 	Rewrite *HTTPRewrite `json:"rewrite,omitempty"`
 
 	// Timeout for HTTP requests.
-	Timeout *string `json:"timeout,omitempty"`
+	Timeout *v1alpha1.Duration `json:"timeout,omitempty"`
 
 	// Retry policy for HTTP requests.
 	Retries *HTTPRetry `json:"retries,omitempty"`
@@ -734,6 +735,7 @@ type TCPRoute struct {
 	// activated. All conditions inside a single match block have AND
 	// semantics, while the list of match blocks have OR semantics. The rule
 	// is matched if any one of the match blocks succeed.
+	// +optional
 	Match []L4MatchAttributes `json:"match"`
 
 	// The destination to which the connection should be forwarded to.
@@ -936,7 +938,8 @@ type HTTPRetry struct {
 	Attempts int `json:"attempts"`
 
 	// Timeout per retry attempt for a given request. format: 1h/1m/1s/1ms. MUST BE >=1ms.
-	PerTryTimeout string `json:"perTryTimeout"`
+	// +optional
+	PerTryTimeout v1alpha1.Duration `json:"perTryTimeout,omitzero"`
 
 	// Specifies the conditions under which retry takes place.
 	// One or more policies can be specified using a ‘,’ delimited list.
@@ -997,7 +1000,7 @@ type CorsPolicy struct {
 
 	// Specifies how long the results of a preflight request can be
 	// cached. Translates to the `Access-Control-Max-Age` header.
-	MaxAge *string `json:"maxAge,omitempty"`
+	MaxAge *v1alpha1.Duration `json:"maxAge,omitempty"`
 
 	// Indicates whether the caller is allowed to send the actual request
 	// (not the preflight) using credentials. Translates to
@@ -1057,7 +1060,7 @@ type HTTPFaultInjection struct {
 type Delay struct {
 	// REQUIRED. Add a fixed delay before forwarding the request. Format:
 	// 1h/1m/1s/1ms. MUST be >=1ms.
-	FixedDelay string `json:"fixedDelay"`
+	FixedDelay v1alpha1.Duration `json:"fixedDelay"`
 
 	// Percentage of requests on which the delay will be injected.
 	Percentage *Percentage `json:"percentage,omitempty"`
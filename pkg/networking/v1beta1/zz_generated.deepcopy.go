@@ -1,4 +1,4 @@
-// +build !ignore_autogenerated
+//go:build !ignore_autogenerated
 
 // Copyright © 2019 Banzai Cloud
 //
@@ -128,7 +128,7 @@ func (in *CorsPolicy) DeepCopyInto(out *CorsPolicy) {
 	}
 	if in.MaxAge != nil {
 		in, out := &in.MaxAge, &out.MaxAge
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.AllowCredentials != nil {
@@ -151,6 +151,7 @@ func (in *CorsPolicy) DeepCopy() *CorsPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Delay) DeepCopyInto(out *Delay) {
 	*out = *in
+	out.FixedDelay = in.FixedDelay
 	if in.Percentage != nil {
 		in, out := &in.Percentage, &out.Percentage
 		*out = new(Percentage)
@@ -223,7 +224,7 @@ func (in *DestinationRule) DeepCopyObject() runtime.Object {
 func (in *DestinationRuleList) DeepCopyInto(out *DestinationRuleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]DestinationRule, len(*in))
@@ -313,7 +314,7 @@ func (in *Gateway) DeepCopyObject() runtime.Object {
 func (in *GatewayList) DeepCopyInto(out *GatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Gateway, len(*in))
@@ -378,6 +379,7 @@ func (in *HTTPCookie) DeepCopyInto(out *HTTPCookie) {
 		*out = new(string)
 		**out = **in
 	}
+	out.TTL = in.TTL
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCookie.
@@ -470,7 +472,8 @@ func (in *HTTPMatchRequest) DeepCopyInto(out *HTTPMatchRequest) {
 			if val == nil {
 				(*out)[key] = nil
 			} else {
-				in, out := &val, &outVal
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(v1alpha1.StringMatch)
 				**out = **in
 			}
@@ -527,6 +530,7 @@ func (in *HTTPRedirect) DeepCopy() *HTTPRedirect {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
 	*out = *in
+	out.PerTryTimeout = in.PerTryTimeout
 	if in.RetryOn != nil {
 		in, out := &in.RetryOn, &out.RetryOn
 		*out = new(string)
@@ -611,7 +615,7 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.Retries != nil {
@@ -711,7 +715,7 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 	}
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.H2UpgradePolicy != nil {
@@ -922,12 +926,12 @@ func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	*out = *in
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.BaseEjectionTime != nil {
 		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.MaxEjectionPercent != nil {
@@ -1157,7 +1161,7 @@ func (in *ServiceEntryEndpoint) DeepCopy() *ServiceEntryEndpoint {
 func (in *ServiceEntryList) DeepCopyInto(out *ServiceEntryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]ServiceEntry, len(*in))
@@ -1282,7 +1286,7 @@ func (in *Sidecar) DeepCopyObject() runtime.Object {
 func (in *SidecarList) DeepCopyInto(out *SidecarList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]Sidecar, len(*in))
@@ -1394,12 +1398,12 @@ func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	}
 	if in.Time != nil {
 		in, out := &in.Time, &out.Time
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 }
@@ -1457,7 +1461,7 @@ func (in *TCPSettings) DeepCopyInto(out *TCPSettings) {
 	}
 	if in.ConnectTimeout != nil {
 		in, out := &in.ConnectTimeout, &out.ConnectTimeout
-		*out = new(string)
+		*out = new(v1alpha1.Duration)
 		**out = **in
 	}
 	if in.TCPKeepalive != nil {
@@ -1650,6 +1654,11 @@ func (in *TLSSettings) DeepCopyInto(out *TLSSettings) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CredentialName != nil {
+		in, out := &in.CredentialName, &out.CredentialName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSettings.
@@ -1750,7 +1759,7 @@ func (in *VirtualService) DeepCopyObject() runtime.Object {
 func (in *VirtualServiceList) DeepCopyInto(out *VirtualServiceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]VirtualService, len(*in))
@@ -1859,7 +1868,7 @@ func (in *WorkloadEntry) DeepCopyObject() runtime.Object {
 func (in *WorkloadEntryList) DeepCopyInto(out *WorkloadEntryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]WorkloadEntry, len(*in))
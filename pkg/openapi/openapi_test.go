@@ -0,0 +1,58 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+func TestCustomDefinitions(t *testing.T) {
+	definitions := GetOpenAPIDefinitions(func(path string) spec.Ref {
+		return spec.MustCreateRef("#/definitions/" + path)
+	})
+
+	patch := definitions["github.com/banzaicloud/istio-client-go/pkg/networking/v1alpha3.Patch"].Schema
+	value, ok := patch.Properties["value"]
+	if !ok {
+		t.Fatal("Patch has no value property")
+	}
+	if preserve, _ := value.Extensions.GetBool("x-kubernetes-preserve-unknown-fields"); !preserve {
+		t.Error("Patch value does not preserve unknown fields")
+	}
+	if operation := patch.Properties["operation"]; len(operation.Enum) != 7 {
+		t.Errorf("expected 7 patch operations, got %v", operation.Enum)
+	}
+
+	peerAuthentication := definitions["github.com/banzaicloud/istio-client-go/pkg/security/v1beta1.PeerAuthenticationSpec"].Schema
+	for _, property := range []string{"selector", "mtls", "portLevelMtls"} {
+		if _, ok := peerAuthentication.Properties[property]; !ok {
+			t.Errorf("PeerAuthenticationSpec has no %s property", property)
+		}
+	}
+	portLevelMtlsProperty := peerAuthentication.Properties["portLevelMtls"]
+	if ref := portLevelMtlsProperty.Ref.String(); ref != "#/definitions/github.com/banzaicloud/istio-client-go/pkg/security/v1beta1.PortLevelMTLS" {
+		t.Errorf("portLevelMtls refers to %q", ref)
+	}
+
+	portLevelMtls := definitions["github.com/banzaicloud/istio-client-go/pkg/security/v1beta1.PortLevelMTLS"].Schema
+	if portLevelMtls.AdditionalProperties == nil || portLevelMtls.AdditionalProperties.Schema == nil {
+		t.Fatal("PortLevelMTLS is not described as a map")
+	}
+	if _, ok := portLevelMtls.AdditionalProperties.Schema.Properties["mode"]; !ok {
+		t.Error("PortLevelMTLS values have no mode property")
+	}
+}
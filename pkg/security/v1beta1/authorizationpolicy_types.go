@@ -150,6 +150,7 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;security-istio-io
 // AuthorizationPolicy
 type AuthorizationPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -201,6 +202,7 @@ type AuthorizationPolicySpec struct {
 }
 
 // Action specifies the operation to take.
+// +kubebuilder:validation:Enum=ALLOW;DENY
 type AuthorizationPolicyAction string
 
 const (
@@ -0,0 +1,157 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authz evaluates requests against AuthorizationPolicy resources
+// offline, following the semantics of the Istio proxy.
+package authz
+
+import (
+	"net/http"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// DefaultRootNamespace is the namespace Istio reads mesh wide policies from
+// unless configured otherwise.
+const DefaultRootNamespace = "istio-system"
+
+// Workload identifies the workload receiving the request.
+type Workload struct {
+	Namespace string
+	Labels    map[string]string
+}
+
+// Request describes the attributes of a request as seen by the proxy of the
+// receiving workload.
+type Request struct {
+	// Principal is the peer identity, e.g. "cluster.local/ns/default/sa/sleep".
+	Principal string
+	// RequestPrincipal is the "iss/sub" identity of an authenticated JWT.
+	RequestPrincipal string
+	SourceNamespace  string
+	SourceIP         string
+	DestinationIP    string
+	SNI              string
+	Host             string
+	Port             uint32
+	Method           string
+	// Path may contain a query string, it is ignored during matching.
+	Path    string
+	Headers http.Header
+	// Claims holds the claims of an authenticated JWT, values are strings or
+	// lists of strings the way they are decoded from JSON.
+	Claims map[string]interface{}
+}
+
+// Result is the outcome of an evaluation.
+type Result struct {
+	Action v1beta1.AuthorizationPolicyAction
+	// Policy is the policy whose rule decided the request. It is nil if the
+	// request was decided by default.
+	Policy *v1beta1.AuthorizationPolicy
+	// Rule is the index of the matching rule within Policy, or -1.
+	Rule int
+}
+
+// Evaluator decides requests against a set of authorization policies.
+type Evaluator struct {
+	// RootNamespace holds the policies which apply to the whole mesh. Defaults
+	// to DefaultRootNamespace when empty.
+	RootNamespace string
+	Policies      []v1beta1.AuthorizationPolicy
+}
+
+// Evaluate decides the request with the policies applying to the workload,
+// using the default root namespace.
+func Evaluate(policies []v1beta1.AuthorizationPolicy, workload Workload, request Request) Result {
+	e := Evaluator{Policies: policies}
+	return e.Evaluate(workload, request)
+}
+
+// Evaluate decides the request the same way the proxy of the workload would:
+// a matching DENY policy denies the request, otherwise it is allowed if there
+// are no ALLOW policies for the workload or one of them matches, and denied
+// in every other case.
+func (e *Evaluator) Evaluate(workload Workload, request Request) Result {
+	var allowPolicies, denyPolicies []*v1beta1.AuthorizationPolicy
+	for _, policy := range e.applicablePolicies(workload) {
+		if policy.Spec.Action == v1beta1.AuthorizationPolicyActionDeny {
+			denyPolicies = append(denyPolicies, policy)
+		} else {
+			allowPolicies = append(allowPolicies, policy)
+		}
+	}
+
+	for _, policy := range denyPolicies {
+		if rule := matchPolicy(policy, &request); rule >= 0 {
+			return Result{Action: v1beta1.AuthorizationPolicyActionDeny, Policy: policy, Rule: rule}
+		}
+	}
+
+	if len(allowPolicies) == 0 {
+		return Result{Action: v1beta1.AuthorizationPolicyActionAllow, Rule: -1}
+	}
+	for _, policy := range allowPolicies {
+		if rule := matchPolicy(policy, &request); rule >= 0 {
+			return Result{Action: v1beta1.AuthorizationPolicyActionAllow, Policy: policy, Rule: rule}
+		}
+	}
+
+	return Result{Action: v1beta1.AuthorizationPolicyActionDeny, Rule: -1}
+}
+
+// applicablePolicies returns the policies of the workload and root namespace
+// selecting the workload, ordered by namespace and name.
+func (e *Evaluator) applicablePolicies(workload Workload) []*v1beta1.AuthorizationPolicy {
+	rootNamespace := e.RootNamespace
+	if rootNamespace == "" {
+		rootNamespace = DefaultRootNamespace
+	}
+
+	var policies []*v1beta1.AuthorizationPolicy
+	for i := range e.Policies {
+		policy := &e.Policies[i]
+		if policy.Namespace != workload.Namespace && policy.Namespace != rootNamespace {
+			continue
+		}
+		if policy.Spec.Selector != nil && !labels.SelectorFromSet(policy.Spec.Selector.MatchLabels).Matches(labels.Set(workload.Labels)) {
+			continue
+		}
+		policies = append(policies, policy)
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		if policies[i].Namespace != policies[j].Namespace {
+			return policies[i].Namespace < policies[j].Namespace
+		}
+		return policies[i].Name < policies[j].Name
+	})
+
+	return policies
+}
+
+// matchPolicy returns the index of the first rule of the policy matching the
+// request, or -1.
+func matchPolicy(policy *v1beta1.AuthorizationPolicy, request *Request) int {
+	for i, rule := range policy.Spec.Rules {
+		if rule != nil && matchRule(rule, request) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,226 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+func policy(namespace, name string, action v1beta1.AuthorizationPolicyAction, rules ...*v1beta1.Rule) v1beta1.AuthorizationPolicy {
+	return v1beta1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: v1beta1.AuthorizationPolicySpec{
+			Action: action,
+			Rules:  rules,
+		},
+	}
+}
+
+func toMethods(methods ...string) *v1beta1.Rule {
+	return &v1beta1.Rule{To: []*v1beta1.RuleTo{{Operation: &v1beta1.Operation{Methods: methods}}}}
+}
+
+func TestEvaluateDenyBeforeAllow(t *testing.T) {
+	workload := Workload{Namespace: "default", Labels: map[string]string{"app": "reviews"}}
+	selected := policy("default", "z-deny-other-app", v1beta1.AuthorizationPolicyActionDeny, &v1beta1.Rule{})
+	selected.Spec.Selector = &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "ratings"}}
+
+	tests := []struct {
+		name     string
+		policies []v1beta1.AuthorizationPolicy
+		method   string
+		action   v1beta1.AuthorizationPolicyAction
+		policy   string
+	}{
+		{
+			name:   "no policies",
+			method: "GET",
+			action: v1beta1.AuthorizationPolicyActionAllow,
+		},
+		{
+			name: "deny wins over an allow sorted before it",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "a-allow-all", v1beta1.AuthorizationPolicyActionAllow, &v1beta1.Rule{}),
+				policy("default", "z-deny-delete", v1beta1.AuthorizationPolicyActionDeny, toMethods("DELETE")),
+			},
+			method: "DELETE",
+			action: v1beta1.AuthorizationPolicyActionDeny,
+			policy: "z-deny-delete",
+		},
+		{
+			name: "allow when no deny matches",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "a-allow-all", v1beta1.AuthorizationPolicyActionAllow, &v1beta1.Rule{}),
+				policy("default", "z-deny-delete", v1beta1.AuthorizationPolicyActionDeny, toMethods("DELETE")),
+			},
+			method: "GET",
+			action: v1beta1.AuthorizationPolicyActionAllow,
+			policy: "a-allow-all",
+		},
+		{
+			name: "allow when only deny policies exist",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "deny-delete", v1beta1.AuthorizationPolicyActionDeny, toMethods("DELETE")),
+			},
+			method: "GET",
+			action: v1beta1.AuthorizationPolicyActionAllow,
+		},
+		{
+			name: "deny when no allow matches",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "allow-get", v1beta1.AuthorizationPolicyActionAllow, toMethods("GET")),
+			},
+			method: "POST",
+			action: v1beta1.AuthorizationPolicyActionDeny,
+		},
+		{
+			name: "allow policy without rules denies",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "allow-nothing", v1beta1.AuthorizationPolicyActionAllow),
+			},
+			method: "GET",
+			action: v1beta1.AuthorizationPolicyActionDeny,
+		},
+		{
+			name: "root namespace deny",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("default", "allow-all", v1beta1.AuthorizationPolicyActionAllow, &v1beta1.Rule{}),
+				policy(DefaultRootNamespace, "deny-delete", v1beta1.AuthorizationPolicyActionDeny, toMethods("DELETE")),
+			},
+			method: "DELETE",
+			action: v1beta1.AuthorizationPolicyActionDeny,
+			policy: "deny-delete",
+		},
+		{
+			name: "policies of other namespaces and workloads are ignored",
+			policies: []v1beta1.AuthorizationPolicy{
+				policy("other", "deny-all", v1beta1.AuthorizationPolicyActionDeny, &v1beta1.Rule{}),
+				selected,
+			},
+			method: "GET",
+			action: v1beta1.AuthorizationPolicyActionAllow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Evaluate(tt.policies, workload, Request{Method: tt.method})
+			if result.Action != tt.action {
+				t.Errorf("expected %s, got %s", tt.action, result.Action)
+			}
+			name := ""
+			if result.Policy != nil {
+				name = result.Policy.Name
+			}
+			if name != tt.policy {
+				t.Errorf("expected the request to be decided by %q, got %q", tt.policy, name)
+			}
+		})
+	}
+}
+
+func TestEvaluateNegation(t *testing.T) {
+	request := Request{
+		Principal:       "cluster.local/ns/default/sa/sleep",
+		SourceNamespace: "default",
+		SourceIP:        "10.0.0.5",
+		Host:            "reviews.default.svc.cluster.local",
+		Port:            9080,
+		Method:          "GET",
+		Path:            "/admin/public?verbose=1",
+		Headers:         http.Header{"X-Team": []string{"blue"}},
+	}
+	from := func(source v1beta1.Source) *v1beta1.Rule {
+		return &v1beta1.Rule{From: []*v1beta1.RuleFrom{{Source: &source}}}
+	}
+	to := func(operation v1beta1.Operation) *v1beta1.Rule {
+		return &v1beta1.Rule{To: []*v1beta1.RuleTo{{Operation: &operation}}}
+	}
+	when := func(condition v1beta1.Condition) *v1beta1.Rule {
+		return &v1beta1.Rule{When: []*v1beta1.Condition{&condition}}
+	}
+
+	tests := []struct {
+		name    string
+		rule    *v1beta1.Rule
+		matches bool
+	}{
+		{name: "notPrincipals excludes the principal", rule: from(v1beta1.Source{NotPrincipals: []string{"*/sa/sleep"}}), matches: false},
+		{name: "notPrincipals of another principal", rule: from(v1beta1.Source{NotPrincipals: []string{"*/sa/admin"}}), matches: true},
+		{name: "notNamespaces", rule: from(v1beta1.Source{NotNamespaces: []string{"default"}}), matches: false},
+		{name: "notIpBlocks", rule: from(v1beta1.Source{NotIPBlocks: []string{"10.0.0.0/24"}}), matches: false},
+		{name: "notIpBlocks of another block", rule: from(v1beta1.Source{NotIPBlocks: []string{"10.1.0.0/16"}}), matches: true},
+		{name: "notRequestPrincipals with no jwt", rule: from(v1beta1.Source{NotRequestPrincipals: []string{"*"}}), matches: true},
+		{name: "notHosts ignores case", rule: to(v1beta1.Operation{NotHosts: []string{"Reviews.*"}}), matches: false},
+		{name: "notPorts", rule: to(v1beta1.Operation{NotPorts: []string{"9080"}}), matches: false},
+		{name: "notMethods", rule: to(v1beta1.Operation{NotMethods: []string{"POST", "DELETE"}}), matches: true},
+		{name: "notPaths ignores the query", rule: to(v1beta1.Operation{NotPaths: []string{"/admin/public"}}), matches: false},
+		{
+			name:    "paths with an excluded subpath",
+			rule:    to(v1beta1.Operation{Paths: []string{"/admin/*"}, NotPaths: []string{"/admin/public"}}),
+			matches: false,
+		},
+		{
+			name:    "methods with other excluded methods",
+			rule:    to(v1beta1.Operation{Methods: []string{"GET"}, NotMethods: []string{"DELETE"}}),
+			matches: true,
+		},
+		{name: "notValues of a header", rule: when(v1beta1.Condition{Key: "request.headers[x-team]", NotValues: []string{"blue"}}), matches: false},
+		{name: "notValues of another header value", rule: when(v1beta1.Condition{Key: "request.headers[x-team]", NotValues: []string{"red"}}), matches: true},
+		{name: "notValues of a missing header", rule: when(v1beta1.Condition{Key: "request.headers[x-other]", NotValues: []string{"*"}}), matches: true},
+		{name: "notValues of an unknown key", rule: when(v1beta1.Condition{Key: "request.unknown", NotValues: []string{"blue"}}), matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := []v1beta1.AuthorizationPolicy{
+				policy("default", "allow", v1beta1.AuthorizationPolicyActionAllow, tt.rule),
+			}
+			result := Evaluate(policies, Workload{Namespace: "default"}, request)
+			if matched := result.Action == v1beta1.AuthorizationPolicyActionAllow; matched != tt.matches {
+				t.Errorf("expected the rule to match: %t, got %t", tt.matches, matched)
+			}
+		})
+	}
+}
+
+func TestMatchString(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		matches bool
+	}{
+		{pattern: "*", value: "any", matches: true},
+		{pattern: "*", value: "", matches: false},
+		{pattern: "*.example.com", value: "api.example.com", matches: true},
+		{pattern: "*.example.com", value: "example.com", matches: false},
+		{pattern: "/api/*", value: "/api/v1", matches: true},
+		{pattern: "/api/*", value: "/status", matches: false},
+		{pattern: "GET", value: "GET", matches: true},
+		{pattern: "GET", value: "get", matches: false},
+	}
+
+	for _, tt := range tests {
+		if matches := MatchString(tt.pattern, tt.value); matches != tt.matches {
+			t.Errorf("%q against %q: expected %t, got %t", tt.pattern, tt.value, tt.matches, matches)
+		}
+	}
+}
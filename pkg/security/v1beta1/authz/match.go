@@ -0,0 +1,201 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// matchRule reports whether the request matches any source, any operation
+// and all conditions of the rule. Empty lists match every request.
+func matchRule(rule *v1beta1.Rule, request *Request) bool {
+	if len(rule.From) > 0 {
+		matched := false
+		for _, from := range rule.From {
+			if from != nil && from.Source != nil && matchSource(from.Source, request) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if len(rule.To) > 0 {
+		matched := false
+		for _, to := range rule.To {
+			if to != nil && to.Operation != nil && matchOperation(to.Operation, request) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, condition := range rule.When {
+		if condition != nil && !matchCondition(condition, request) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchSource(source *v1beta1.Source, request *Request) bool {
+	return matchValues(source.Principals, source.NotPrincipals, []string{request.Principal}, MatchString) &&
+		matchValues(source.RequestPrincipals, source.NotRequestPrincipals, []string{request.RequestPrincipal}, MatchString) &&
+		matchValues(source.Namespaces, source.NotNamespaces, []string{request.SourceNamespace}, MatchString) &&
+		matchValues(source.IPBlocks, source.NotIPBlocks, []string{request.SourceIP}, matchIP)
+}
+
+func matchOperation(operation *v1beta1.Operation, request *Request) bool {
+	port := ""
+	if request.Port != 0 {
+		port = strconv.FormatUint(uint64(request.Port), 10)
+	}
+	path := request.Path
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	return matchValues(operation.Hosts, operation.NotHosts, []string{request.Host}, matchHost) &&
+		matchValues(operation.Ports, operation.NotPorts, []string{port}, MatchString) &&
+		matchValues(operation.Methods, operation.NotMethods, []string{request.Method}, MatchString) &&
+		matchValues(operation.Paths, operation.NotPaths, []string{path}, MatchString)
+}
+
+func matchCondition(condition *v1beta1.Condition, request *Request) bool {
+	var values []string
+	match := MatchString
+
+	key := condition.Key
+	switch {
+	case strings.HasPrefix(key, "request.headers[") && strings.HasSuffix(key, "]"):
+		name := key[len("request.headers[") : len(key)-1]
+		values = request.Headers.Values(name)
+		if len(values) == 0 {
+			values = []string{""}
+		}
+	case strings.HasPrefix(key, "request.auth.claims[") && strings.HasSuffix(key, "]"):
+		values = claimValues(request.Claims, key[len("request.auth.claims["):len(key)-1])
+	case key == "source.ip":
+		values, match = []string{request.SourceIP}, matchIP
+	case key == "source.namespace":
+		values = []string{request.SourceNamespace}
+	case key == "source.principal":
+		values = []string{request.Principal}
+	case key == "request.auth.principal":
+		values = []string{request.RequestPrincipal}
+	case key == "request.auth.audiences":
+		values = claimValues(request.Claims, "aud")
+	case key == "request.auth.presenter":
+		values = claimValues(request.Claims, "azp")
+	case key == "destination.ip":
+		values, match = []string{request.DestinationIP}, matchIP
+	case key == "destination.port":
+		values = []string{strconv.FormatUint(uint64(request.Port), 10)}
+	case key == "connection.sni":
+		values = []string{request.SNI}
+	default:
+		// Unknown attributes never match, the same way the proxy rejects them.
+		return false
+	}
+
+	return matchValues(condition.Values, condition.NotValues, values, match)
+}
+
+// matchValues reports whether any of the attribute values matches one of the
+// patterns, if there are any, and none of them matches a negated pattern.
+func matchValues(patterns, notPatterns []string, values []string, match func(pattern, value string) bool) bool {
+	if len(patterns) > 0 && !matchAny(patterns, values, match) {
+		return false
+	}
+
+	return !matchAny(notPatterns, values, match)
+}
+
+func matchAny(patterns, values []string, match func(pattern, value string) bool) bool {
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if match(pattern, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MatchString implements the exact, prefix, suffix and presence matching
+// supported by string fields of authorization rules: "*" matches any
+// non-empty value, "*suffix" and "prefix*" match by suffix and prefix, and
+// other patterns match exactly.
+func MatchString(pattern, value string) bool {
+	switch {
+	case pattern == "*":
+		return value != ""
+	case strings.HasPrefix(pattern, "*"):
+		return strings.HasSuffix(value, pattern[1:])
+	case strings.HasSuffix(pattern, "*"):
+		return strings.HasPrefix(value, pattern[:len(pattern)-1])
+	default:
+		return pattern == value
+	}
+}
+
+func matchHost(pattern, value string) bool {
+	return MatchString(strings.ToLower(pattern), strings.ToLower(value))
+}
+
+func matchIP(block, value string) bool {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return false
+	}
+	if !strings.Contains(block, "/") {
+		other := net.ParseIP(block)
+		return other != nil && other.Equal(ip)
+	}
+	_, cidr, err := net.ParseCIDR(block)
+
+	return err == nil && cidr.Contains(ip)
+}
+
+func claimValues(claims map[string]interface{}, name string) []string {
+	switch claim := claims[name].(type) {
+	case nil:
+		return []string{""}
+	case string:
+		return []string{claim}
+	case []string:
+		return claim
+	case []interface{}:
+		values := make([]string, 0, len(claim))
+		for _, value := range claim {
+			values = append(values, fmt.Sprint(value))
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(claim)}
+	}
+}
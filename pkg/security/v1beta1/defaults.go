@@ -0,0 +1,49 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func addDefaultingFuncs(scheme *runtime.Scheme) error {
+	return RegisterDefaults(scheme)
+}
+
+func SetDefaults_AuthorizationPolicySpec(obj *AuthorizationPolicySpec) {
+	if obj.Action == "" {
+		obj.Action = AuthorizationPolicyActionAllow
+	}
+}
+
+// SetDefaults_PeerAuthenticationSpec makes the inheritance of the mTLS mode
+// from the parent explicit.
+func SetDefaults_PeerAuthenticationSpec(obj *PeerAuthenticationSpec) {
+	if obj.Mtls == nil {
+		obj.Mtls = &PeerAuthenticationMTLS{}
+	}
+	// the generated defaulters do not descend into maps
+	for _, mtls := range obj.PortLevelMtls.Ports {
+		if mtls != nil {
+			SetDefaults_PeerAuthenticationMTLS(mtls)
+		}
+	}
+}
+
+func SetDefaults_PeerAuthenticationMTLS(obj *PeerAuthenticationMTLS) {
+	if obj.Mode == "" {
+		obj.Mode = MTLSModeUnset
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDefaults(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected runtime.Object
+	}{
+		{
+			name:     "authorization policy",
+			obj:      &AuthorizationPolicy{},
+			expected: &AuthorizationPolicy{Spec: AuthorizationPolicySpec{Action: AuthorizationPolicyActionAllow}},
+		},
+		{
+			name:     "authorization policy with action",
+			obj:      &AuthorizationPolicy{Spec: AuthorizationPolicySpec{Action: AuthorizationPolicyActionDeny}},
+			expected: &AuthorizationPolicy{Spec: AuthorizationPolicySpec{Action: AuthorizationPolicyActionDeny}},
+		},
+		{
+			name:     "peer authentication",
+			obj:      &PeerAuthentication{},
+			expected: &PeerAuthentication{Spec: PeerAuthenticationSpec{Mtls: &PeerAuthenticationMTLS{Mode: MTLSModeUnset}}},
+		},
+		{
+			name: "port level modes",
+			obj: &PeerAuthentication{Spec: PeerAuthenticationSpec{
+				Mtls: &PeerAuthenticationMTLS{Mode: MTLSModeStrict},
+				PortLevelMtls: PortLevelMTLS{Ports: map[uint32]*PeerAuthenticationMTLS{
+					8080: {},
+					9090: {Mode: MTLSModeDisable},
+					9091: nil,
+				}},
+			}},
+			expected: &PeerAuthentication{Spec: PeerAuthenticationSpec{
+				Mtls: &PeerAuthenticationMTLS{Mode: MTLSModeStrict},
+				PortLevelMtls: PortLevelMTLS{Ports: map[uint32]*PeerAuthenticationMTLS{
+					8080: {Mode: MTLSModeUnset},
+					9090: {Mode: MTLSModeDisable},
+					9091: nil,
+				}},
+			}},
+		},
+		{
+			name:     "peer authentication list",
+			obj:      &PeerAuthenticationList{Items: []PeerAuthentication{{}}},
+			expected: &PeerAuthenticationList{Items: []PeerAuthentication{{Spec: PeerAuthenticationSpec{Mtls: &PeerAuthenticationMTLS{Mode: MTLSModeUnset}}}}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme.Default(tt.obj)
+			if !reflect.DeepEqual(tt.obj, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, tt.obj)
+			}
+		})
+	}
+}
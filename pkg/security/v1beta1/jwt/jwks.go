@@ -0,0 +1,269 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256" // register the hashes of the supported algorithms
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+)
+
+// KeySource returns the JSON Web Key Sets published at JwksURI.
+type KeySource interface {
+	KeySet(ctx context.Context, uri string) ([]byte, error)
+}
+
+// KeySourceFunc adapts a function to a KeySource.
+type KeySourceFunc func(ctx context.Context, uri string) ([]byte, error)
+
+// KeySet calls f.
+func (f KeySourceFunc) KeySet(ctx context.Context, uri string) ([]byte, error) {
+	return f(ctx, uri)
+}
+
+// StaticKeySource serves key sets by URI from memory, e.g. to stand in for
+// the issuer in tests.
+type StaticKeySource map[string]string
+
+// KeySet returns the key set of the URI.
+func (s StaticKeySource) KeySet(_ context.Context, uri string) ([]byte, error) {
+	keySet, ok := s[uri]
+	if !ok {
+		return nil, fmt.Errorf("no key set for %s", uri)
+	}
+
+	return []byte(keySet), nil
+}
+
+// HTTPKeySource fetches key sets with Client, http.DefaultClient if nil.
+type HTTPKeySource struct {
+	Client *http.Client
+}
+
+// KeySet fetches the key set from the URI.
+func (s *HTTPKeySource) KeySet(ctx context.Context, uri string) ([]byte, error) {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", uri, response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
+
+// jsonWebKey holds the members of the supported key types, see RFC 7517 and
+// RFC 7518.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	K   string `json:"k"`
+}
+
+type key struct {
+	id  string
+	alg string
+	// public is an *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey, or
+	// the []byte secret of HMAC keys.
+	public interface{}
+}
+
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+// parseKeySet parses a JSON Web Key Set. Keys of unsupported types are
+// skipped, like the proxy does.
+func parseKeySet(data []byte) ([]key, error) {
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &keySet); err != nil {
+		return nil, fmt.Errorf("invalid key set: %w", err)
+	}
+
+	var keys []key
+	for i, jwk := range keySet.Keys {
+		public, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %d of key set: %w", i, err)
+		}
+		if public != nil {
+			keys = append(keys, key{id: jwk.Kid, alg: jwk.Alg, public: public})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("key set has no supported keys")
+	}
+
+	return keys, nil
+}
+
+func (jwk *jsonWebKey) publicKey() (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("modulus: %w", err)
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, ok := curves[jwk.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("y: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeSegment(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid x")
+		}
+		return ed25519.PublicKey(x), nil
+	case "oct":
+		k, err := decodeSegment(jwk.K)
+		if err != nil || len(k) == 0 {
+			return nil, errors.New("invalid k")
+		}
+		return k, nil
+	default:
+		return nil, nil
+	}
+}
+
+// hashes are the hashes of the supported algorithms, except EdDSA.
+var hashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"PS256": crypto.SHA256, "PS384": crypto.SHA384, "PS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+	"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+}
+
+// ecdsaCurves are the curves of the ECDSA algorithms.
+var ecdsaCurves = map[string]string{
+	"ES256": "P-256",
+	"ES384": "P-384",
+	"ES512": "P-521",
+}
+
+// verifySignature reports whether the signature of the signing input is
+// valid for the key with the algorithm.
+func verifySignature(alg string, k *key, signingInput, signature []byte) bool {
+	if k.alg != "" && k.alg != alg {
+		return false
+	}
+
+	if alg == "EdDSA" {
+		public, ok := k.public.(ed25519.PublicKey)
+		return ok && ed25519.Verify(public, signingInput, signature)
+	}
+	hash, ok := hashes[alg]
+	if !ok {
+		return false
+	}
+	h := hash.New()
+	h.Write(signingInput)
+	digest := h.Sum(nil)
+
+	switch public := k.public.(type) {
+	case *rsa.PublicKey:
+		switch alg[:2] {
+		case "RS":
+			return rsa.VerifyPKCS1v15(public, hash, digest, signature) == nil
+		case "PS":
+			return rsa.VerifyPSS(public, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+	case *ecdsa.PublicKey:
+		size := (public.Curve.Params().BitSize + 7) / 8
+		if curves[ecdsaCurves[alg]] != public.Curve || len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(public, digest, r, s)
+	case []byte:
+		if alg[:2] != "HS" {
+			return false
+		}
+		mac := hmac.New(hash.New, public)
+		mac.Write(signingInput)
+		return hmac.Equal(mac.Sum(nil), signature)
+	}
+
+	return false
+}
+
+func decodeSegment(segment string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+}
+
+func decodeBigInt(segment string) (*big.Int, error) {
+	b, err := decodeSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,329 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jwt verifies the tokens of requests against the JWTRule resources
+// of RequestAuthentication policies offline, the way the proxy does.
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+const (
+	// DefaultHeader and DefaultHeaderPrefix locate tokens when a rule sets no
+	// location.
+	DefaultHeader       = "Authorization"
+	DefaultHeaderPrefix = "Bearer "
+	// DefaultParam locates tokens in the query when a rule sets no location.
+	DefaultParam = "access_token"
+	// ClockSkew is the tolerance of the proxy when checking the validity
+	// period of tokens.
+	ClockSkew = 60 * time.Second
+)
+
+var (
+	// ErrNoToken is returned when the request carries no token at the
+	// locations of the rules. The proxy accepts such requests, but they have
+	// no request principal.
+	ErrNoToken = errors.New("no token found in request")
+	// ErrUnknownIssuer is returned when no rule matches the issuer of the token.
+	ErrUnknownIssuer = errors.New("issuer of token is not configured")
+	// ErrInvalidSignature is returned when no key of the rule verifies the
+	// signature of the token.
+	ErrInvalidSignature = errors.New("invalid token signature")
+	// ErrExpired is returned for tokens past their expiration time.
+	ErrExpired = errors.New("token is expired")
+	// ErrNotYetValid is returned for tokens before their not before time.
+	ErrNotYetValid = errors.New("token is not yet valid")
+	// ErrAudience is returned when no audience of the token is allowed by
+	// the rule.
+	ErrAudience = errors.New("audience of token is not allowed")
+)
+
+// Result is a verified token.
+type Result struct {
+	// Rule is the rule the token was verified with.
+	Rule  *v1beta1.JWTRule
+	Token string
+	// Claims are the claims of the token decoded from JSON, with numbers as
+	// json.Number.
+	Claims map[string]interface{}
+	// Principal is the request principal, "iss/sub".
+	Principal string
+}
+
+// Verifier verifies tokens against a set of rules.
+type Verifier struct {
+	Rules []*v1beta1.JWTRule
+	// KeySource fetches the key sets of rules with a JwksURI instead of
+	// inline Jwks. Defaults to an HTTPKeySource when nil.
+	KeySource KeySource
+	// Now returns the time tokens are checked at, time.Now when nil.
+	Now func() time.Time
+}
+
+// NewVerifier returns a verifier of the rules fetching key sets from source.
+func NewVerifier(rules []*v1beta1.JWTRule, source KeySource) *Verifier {
+	return &Verifier{
+		Rules:     rules,
+		KeySource: source,
+	}
+}
+
+// Verify looks up the token of the request at the locations of the rules,
+// and verifies it with the rule of its issuer: the signature against the key
+// set of the rule, the audiences, and the validity period.
+//
+// Rules without FromHeaders and FromParams look up tokens in the
+// DefaultHeader with the DefaultHeaderPrefix and in the DefaultParam.
+func (v *Verifier) Verify(ctx context.Context, request *http.Request) (*Result, error) {
+	found := false
+	for _, rule := range v.Rules {
+		if rule == nil {
+			continue
+		}
+		token := extractToken(rule, request)
+		if token == "" {
+			continue
+		}
+		found = true
+
+		t, err := parseToken(token)
+		if err != nil {
+			return nil, err
+		}
+		if issuer, _ := t.claims["iss"].(string); issuer != rule.Issuer {
+			continue
+		}
+
+		return v.verify(ctx, rule, t)
+	}
+
+	if !found {
+		return nil, ErrNoToken
+	}
+
+	return nil, ErrUnknownIssuer
+}
+
+func (v *Verifier) verify(ctx context.Context, rule *v1beta1.JWTRule, t *token) (*Result, error) {
+	keys, err := v.keys(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	verified := false
+	for i := range keys {
+		k := &keys[i]
+		if t.keyID != "" && k.id != "" && t.keyID != k.id {
+			continue
+		}
+		if verifySignature(t.alg, k, t.signingInput, t.signature) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, ErrInvalidSignature
+	}
+
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+	if exp, ok, err := t.numericDate("exp"); err != nil {
+		return nil, err
+	} else if ok && !now.Before(exp.Add(ClockSkew)) {
+		return nil, ErrExpired
+	}
+	if nbf, ok, err := t.numericDate("nbf"); err != nil {
+		return nil, err
+	} else if ok && now.Add(ClockSkew).Before(nbf) {
+		return nil, ErrNotYetValid
+	}
+
+	if len(rule.Audiences) > 0 && !allowedAudience(rule.Audiences, t.claims["aud"]) {
+		return nil, ErrAudience
+	}
+
+	subject, _ := t.claims["sub"].(string)
+
+	return &Result{
+		Rule:      rule,
+		Token:     t.raw,
+		Claims:    t.claims,
+		Principal: rule.Issuer + "/" + subject,
+	}, nil
+}
+
+func (v *Verifier) keys(ctx context.Context, rule *v1beta1.JWTRule) ([]key, error) {
+	if rule.Jwks != "" {
+		return parseKeySet([]byte(rule.Jwks))
+	}
+	if rule.JwksURI == "" {
+		return nil, fmt.Errorf("rule of issuer %s has neither jwks nor jwksUri", rule.Issuer)
+	}
+
+	source := v.KeySource
+	if source == nil {
+		source = &HTTPKeySource{}
+	}
+	keySet, err := source.KeySet(ctx, rule.JwksURI)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseKeySet(keySet)
+}
+
+// extractToken returns the first token found at the locations of the rule.
+func extractToken(rule *v1beta1.JWTRule, request *http.Request) string {
+	headers := rule.FromHeaders
+	params := rule.FromParams
+	if len(headers) == 0 && len(params) == 0 {
+		headers = []*v1beta1.JWTHeader{{Name: DefaultHeader, Prefix: DefaultHeaderPrefix}}
+		params = []string{DefaultParam}
+	}
+
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+		value := request.Header.Get(header.Name)
+		if value == "" || !strings.HasPrefix(value, header.Prefix) {
+			continue
+		}
+		if token := strings.TrimSpace(strings.TrimPrefix(value, header.Prefix)); token != "" {
+			return token
+		}
+	}
+	query := request.URL.Query()
+	for _, param := range params {
+		if token := query.Get(param); token != "" {
+			return token
+		}
+	}
+
+	return ""
+}
+
+func allowedAudience(audiences []string, aud interface{}) bool {
+	var values []string
+	switch aud := aud.(type) {
+	case string:
+		values = []string{aud}
+	case []interface{}:
+		for _, value := range aud {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	for _, value := range values {
+		for _, audience := range audiences {
+			if value == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// token is a parsed, unverified JSON Web Token.
+type token struct {
+	raw          string
+	alg          string
+	keyID        string
+	claims       map[string]interface{}
+	signingInput []byte
+	signature    []byte
+}
+
+func parseToken(raw string) (*token, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token: must have three parts")
+	}
+
+	t := &token{
+		raw:          raw,
+		signingInput: []byte(parts[0] + "." + parts[1]),
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeJSONSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	if header.Alg == "" || header.Alg == "none" {
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Alg)
+	}
+	t.alg, t.keyID = header.Alg, header.Kid
+	if err := decodeJSONSegment(parts[1], &t.claims); err != nil {
+		return nil, fmt.Errorf("malformed token payload: %w", err)
+	}
+	signature, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+	t.signature = signature
+
+	return t, nil
+}
+
+// numericDate returns the time of a NumericDate claim, reporting whether the
+// token has the claim.
+func (t *token) numericDate(name string) (time.Time, bool, error) {
+	value, ok := t.claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("claim %s must be a number", name)
+	}
+	if seconds, err := number.Int64(); err == nil {
+		return time.Unix(seconds, 0), true, nil
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("claim %s: %w", name, err)
+	}
+	whole, fraction := math.Modf(seconds)
+
+	return time.Unix(int64(whole), int64(fraction*float64(time.Second))), true, nil
+}
+
+func decodeJSONSegment(segment string, v interface{}) error {
+	data, err := decodeSegment(segment)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
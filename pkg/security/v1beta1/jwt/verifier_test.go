@@ -0,0 +1,323 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+const (
+	issuer   = "https://issuer.example.com"
+	jwksURI  = "https://issuer.example.com/jwks"
+	secret   = "secret-of-the-issuer"
+	otherKey = "secret-of-someone-else"
+)
+
+var now = time.Unix(1600000000, 0)
+
+func keySet(alg string) string {
+	return `{"keys": [{"kty": "oct", "alg": "` + alg + `", "k": "` + base64.RawURLEncoding.EncodeToString([]byte(secret)) + `"}]}`
+}
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// sign returns a token signed with HS256, or an unsigned one for the "none"
+// algorithm.
+func sign(t *testing.T, alg, key string, claims map[string]interface{}) string {
+	t.Helper()
+
+	signingInput := encodeSegment(t, map[string]string{"alg": alg, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	if alg == "none" {
+		return signingInput + "."
+	}
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func claims(extra map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{"iss": issuer, "sub": "alice"}
+	for name, value := range extra {
+		c[name] = value
+	}
+
+	return c
+}
+
+func verify(rules []*v1beta1.JWTRule, token string) (*Result, error) {
+	request := httptest.NewRequest("GET", "/", nil)
+	if token != "" {
+		request.Header.Set(DefaultHeader, DefaultHeaderPrefix+token)
+	}
+	v := &Verifier{
+		Rules:     rules,
+		KeySource: StaticKeySource{jwksURI: keySet("HS256")},
+		Now:       func() time.Time { return now },
+	}
+
+	return v.Verify(context.Background(), request)
+}
+
+func TestVerifySignature(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  *v1beta1.JWTRule
+		token string
+		err   error
+	}{
+		{
+			name:  "valid",
+			rule:  &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI},
+			token: sign(t, "HS256", secret, claims(nil)),
+		},
+		{
+			name:  "inline key set",
+			rule:  &v1beta1.JWTRule{Issuer: issuer, Jwks: keySet("HS256")},
+			token: sign(t, "HS256", secret, claims(nil)),
+		},
+		{
+			name:  "other key",
+			rule:  &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI},
+			token: sign(t, "HS256", otherKey, claims(nil)),
+			err:   ErrInvalidSignature,
+		},
+		{
+			name:  "algorithm of the key",
+			rule:  &v1beta1.JWTRule{Issuer: issuer, Jwks: keySet("HS512")},
+			token: sign(t, "HS256", secret, claims(nil)),
+			err:   ErrInvalidSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := verify([]*v1beta1.JWTRule{tt.rule}, tt.token)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+			if err == nil && result.Principal != issuer+"/alice" {
+				t.Errorf("expected principal %s/alice, got %s", issuer, result.Principal)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsUnsignedTokens(t *testing.T) {
+	rules := []*v1beta1.JWTRule{{Issuer: issuer, JwksURI: jwksURI}}
+
+	for _, alg := range []string{"none", ""} {
+		if result, err := verify(rules, sign(t, alg, "", claims(nil))); err == nil {
+			t.Errorf("alg %q: expected an error, got %v", alg, result)
+		}
+	}
+}
+
+func TestVerifyIssuer(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []*v1beta1.JWTRule
+		token string
+		err   error
+	}{
+		{
+			name:  "wrong issuer",
+			rules: []*v1beta1.JWTRule{{Issuer: "https://other.example.com", JwksURI: jwksURI}},
+			token: sign(t, "HS256", secret, claims(nil)),
+			err:   ErrUnknownIssuer,
+		},
+		{
+			name: "issuer of a later rule",
+			rules: []*v1beta1.JWTRule{
+				{Issuer: "https://other.example.com", JwksURI: jwksURI},
+				{Issuer: issuer, JwksURI: jwksURI},
+			},
+			token: sign(t, "HS256", secret, claims(nil)),
+		},
+		{
+			name:  "no token",
+			rules: []*v1beta1.JWTRule{{Issuer: issuer, JwksURI: jwksURI}},
+			err:   ErrNoToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verify(tt.rules, tt.token); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyValidityPeriod(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims map[string]interface{}
+		err    error
+	}{
+		{name: "not expired", claims: map[string]interface{}{"exp": now.Add(time.Hour).Unix()}},
+		{name: "expired within the skew", claims: map[string]interface{}{"exp": now.Add(-ClockSkew + time.Second).Unix()}},
+		{name: "expired by the skew", claims: map[string]interface{}{"exp": now.Add(-ClockSkew).Unix()}, err: ErrExpired},
+		{name: "expired beyond the skew", claims: map[string]interface{}{"exp": now.Add(-time.Hour).Unix()}, err: ErrExpired},
+		{name: "fractional expiration", claims: map[string]interface{}{"exp": float64(now.Add(-ClockSkew).Unix()) + 0.5}},
+		{name: "valid since", claims: map[string]interface{}{"nbf": now.Add(-time.Hour).Unix()}},
+		{name: "valid within the skew", claims: map[string]interface{}{"nbf": now.Add(ClockSkew).Unix()}},
+		{name: "valid beyond the skew", claims: map[string]interface{}{"nbf": now.Add(ClockSkew + time.Second).Unix()}, err: ErrNotYetValid},
+	}
+
+	rules := []*v1beta1.JWTRule{{Issuer: issuer, JwksURI: jwksURI}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verify(rules, sign(t, "HS256", secret, claims(tt.claims))); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAudiences(t *testing.T) {
+	tests := []struct {
+		name      string
+		audiences []string
+		aud       interface{}
+		err       error
+	}{
+		{name: "single audience", audiences: []string{"reviews", "ratings"}, aud: "ratings"},
+		{name: "list with an allowed audience", audiences: []string{"reviews", "ratings"}, aud: []string{"details", "reviews"}},
+		{name: "list without an allowed audience", audiences: []string{"reviews", "ratings"}, aud: []string{"details"}, err: ErrAudience},
+		{name: "other audience", audiences: []string{"reviews"}, aud: "details", err: ErrAudience},
+		{name: "no audience", audiences: []string{"reviews"}, err: ErrAudience},
+		{name: "any audience", aud: "details"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := []*v1beta1.JWTRule{{Issuer: issuer, JwksURI: jwksURI, Audiences: tt.audiences}}
+			c := claims(nil)
+			if tt.aud != nil {
+				c["aud"] = tt.aud
+			}
+			if _, err := verify(rules, sign(t, "HS256", secret, c)); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyTokenLocations(t *testing.T) {
+	token := sign(t, "HS256", secret, claims(nil))
+	fromHeader := &v1beta1.JWTRule{
+		Issuer:      issuer,
+		JwksURI:     jwksURI,
+		FromHeaders: []*v1beta1.JWTHeader{{Name: "X-Jwt-Assertion", Prefix: "Token "}},
+	}
+
+	tests := []struct {
+		name    string
+		rule    *v1beta1.JWTRule
+		headers map[string]string
+		target  string
+		err     error
+	}{
+		{
+			name:    "header with prefix",
+			rule:    fromHeader,
+			headers: map[string]string{"X-Jwt-Assertion": "Token " + token},
+		},
+		{
+			name:    "header with prefix and spaces",
+			rule:    fromHeader,
+			headers: map[string]string{"X-Jwt-Assertion": "Token   " + token},
+		},
+		{
+			name:    "header without prefix",
+			rule:    fromHeader,
+			headers: map[string]string{"X-Jwt-Assertion": token},
+			err:     ErrNoToken,
+		},
+		{
+			name:    "default header ignored",
+			rule:    fromHeader,
+			headers: map[string]string{DefaultHeader: DefaultHeaderPrefix + token},
+			err:     ErrNoToken,
+		},
+		{
+			name:    "header without a prefix configured",
+			rule:    &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI, FromHeaders: []*v1beta1.JWTHeader{{Name: "X-Jwt-Assertion"}}},
+			headers: map[string]string{"X-Jwt-Assertion": token},
+		},
+		{
+			name:    "default header",
+			rule:    &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI},
+			headers: map[string]string{DefaultHeader: DefaultHeaderPrefix + token},
+		},
+		{
+			name:   "default param",
+			rule:   &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI},
+			target: "/?" + DefaultParam + "=" + token,
+		},
+		{
+			name:   "param",
+			rule:   &v1beta1.JWTRule{Issuer: issuer, JwksURI: jwksURI, FromParams: []string{"jwt"}},
+			target: "/?jwt=" + token,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.target
+			if target == "" {
+				target = "/"
+			}
+			request := httptest.NewRequest("GET", target, nil)
+			for name, value := range tt.headers {
+				request.Header.Set(name, value)
+			}
+			v := &Verifier{
+				Rules:     []*v1beta1.JWTRule{tt.rule},
+				KeySource: StaticKeySource{jwksURI: keySet("HS256")},
+				Now:       func() time.Time { return now },
+			}
+
+			result, err := v.Verify(context.Background(), request)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+			if err == nil && result.Token != token {
+				t.Errorf("expected the token without its prefix, got %q", result.Token)
+			}
+		})
+	}
+}
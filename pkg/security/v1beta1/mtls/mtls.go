@@ -0,0 +1,174 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mtls computes the mutual TLS mode PeerAuthentication resources
+// enforce on the connections a workload accepts, following the inheritance
+// rules of Istio.
+package mtls
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+// DefaultRootNamespace is the namespace Istio reads mesh wide policies from
+// unless configured otherwise.
+const DefaultRootNamespace = "istio-system"
+
+// DefaultMode is the mode of workloads no policy sets a mode for.
+const DefaultMode = v1beta1.MTLSModePermissive
+
+// Workload identifies the workload accepting connections.
+type Workload struct {
+	Namespace string
+	Labels    map[string]string
+	// Port is the port of the workload accepting connections. Port level
+	// settings are ignored when zero.
+	Port uint32
+}
+
+// Level is the level of the policy hierarchy a policy applies at.
+type Level string
+
+const (
+	// MeshLevel policies are in the root namespace without a selector.
+	MeshLevel Level = "Mesh"
+	// NamespaceLevel policies are in the namespace of the workload without a
+	// selector.
+	NamespaceLevel Level = "Namespace"
+	// WorkloadLevel policies are in the namespace of the workload and select
+	// it.
+	WorkloadLevel Level = "Workload"
+	// PortLevel is the port level setting of the workload level policy.
+	PortLevel Level = "Port"
+)
+
+// Step is a policy applying to the workload at a level.
+type Step struct {
+	Level  Level
+	Policy *v1beta1.PeerAuthentication
+	// Mode is the mode the policy sets at the level, MTLSModeUnset if it
+	// inherits the mode of the level above.
+	Mode v1beta1.MTLSMode
+}
+
+// Result is the effective mode of a workload.
+type Result struct {
+	// Mode is never MTLSModeUnset, it is DefaultMode if no policy sets a mode.
+	Mode v1beta1.MTLSMode
+	// Chain holds the policies applying to the workload from the mesh level
+	// down to the port level. The mode is set by the last step of the chain
+	// with a mode other than MTLSModeUnset.
+	Chain []Step
+}
+
+// Source returns the step setting the mode, or nil if the mode is the
+// default.
+func (r *Result) Source() *Step {
+	for i := len(r.Chain) - 1; i >= 0; i-- {
+		if r.Chain[i].Mode != v1beta1.MTLSModeUnset {
+			return &r.Chain[i]
+		}
+	}
+
+	return nil
+}
+
+// Resolver computes effective modes from a set of peer authentication
+// policies.
+type Resolver struct {
+	// RootNamespace holds the policies which apply to the whole mesh. Defaults
+	// to DefaultRootNamespace when empty.
+	RootNamespace string
+	Policies      []v1beta1.PeerAuthentication
+}
+
+// Resolve returns the effective mode of the workload using the default root
+// namespace.
+func Resolve(policies []v1beta1.PeerAuthentication, workload Workload) Result {
+	r := Resolver{Policies: policies}
+	return r.Resolve(workload)
+}
+
+// Resolve returns the effective mode of the workload. At each level the
+// oldest applicable policy is used, and a level without a mode inherits it
+// from the level above. Port level settings are only honored on workload
+// level policies.
+func (r *Resolver) Resolve(workload Workload) Result {
+	rootNamespace := r.RootNamespace
+	if rootNamespace == "" {
+		rootNamespace = DefaultRootNamespace
+	}
+
+	var mesh, namespace, selected *v1beta1.PeerAuthentication
+	for i := range r.Policies {
+		policy := &r.Policies[i]
+		selector := policy.Spec.Selector
+		hasSelector := selector != nil && len(selector.MatchLabels) > 0
+		switch {
+		case policy.Namespace == workload.Namespace && hasSelector:
+			if labels.SelectorFromSet(selector.MatchLabels).Matches(labels.Set(workload.Labels)) {
+				selected = oldest(selected, policy)
+			}
+		case policy.Namespace == rootNamespace && !hasSelector:
+			mesh = oldest(mesh, policy)
+		case policy.Namespace == workload.Namespace && !hasSelector:
+			namespace = oldest(namespace, policy)
+		}
+	}
+
+	result := Result{Mode: DefaultMode}
+	add := func(level Level, policy *v1beta1.PeerAuthentication, mtls *v1beta1.PeerAuthenticationMTLS) {
+		step := Step{Level: level, Policy: policy, Mode: v1beta1.MTLSModeUnset}
+		if mtls != nil && mtls.Mode != "" {
+			step.Mode = mtls.Mode
+		}
+		if step.Mode != v1beta1.MTLSModeUnset {
+			result.Mode = step.Mode
+		}
+		result.Chain = append(result.Chain, step)
+	}
+	if mesh != nil {
+		add(MeshLevel, mesh, mesh.Spec.Mtls)
+	}
+	if namespace != nil {
+		add(NamespaceLevel, namespace, namespace.Spec.Mtls)
+	}
+	if selected != nil {
+		add(WorkloadLevel, selected, selected.Spec.Mtls)
+		if mtls, ok := selected.Spec.PortLevelMtls.Ports[workload.Port]; ok && workload.Port != 0 {
+			add(PortLevel, selected, mtls)
+		}
+	}
+
+	return result
+}
+
+func oldest(current, candidate *v1beta1.PeerAuthentication) *v1beta1.PeerAuthentication {
+	if current == nil || older(&candidate.ObjectMeta, &current.ObjectMeta) {
+		return candidate
+	}
+
+	return current
+}
+
+func older(x, y *metav1.ObjectMeta) bool {
+	if !x.CreationTimestamp.Equal(&y.CreationTimestamp) {
+		return x.CreationTimestamp.Before(&y.CreationTimestamp)
+	}
+
+	return x.Name < y.Name
+}
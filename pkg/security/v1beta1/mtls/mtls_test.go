@@ -0,0 +1,243 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtls
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+var created = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func policy(namespace, name string, mode v1beta1.MTLSMode) v1beta1.PeerAuthentication {
+	p := v1beta1.PeerAuthentication{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: metav1.NewTime(created)},
+	}
+	if mode != "" {
+		p.Spec.Mtls = &v1beta1.PeerAuthenticationMTLS{Mode: mode}
+	}
+
+	return p
+}
+
+func workloadPolicy(namespace, name string, mode v1beta1.MTLSMode, ports map[uint32]v1beta1.MTLSMode) v1beta1.PeerAuthentication {
+	p := policy(namespace, name, mode)
+	p.Spec.Selector = &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}}
+	if len(ports) > 0 {
+		p.Spec.PortLevelMtls.Ports = map[uint32]*v1beta1.PeerAuthenticationMTLS{}
+		for port, portMode := range ports {
+			p.Spec.PortLevelMtls.Ports[port] = &v1beta1.PeerAuthenticationMTLS{Mode: portMode}
+		}
+	}
+
+	return p
+}
+
+func TestResolve(t *testing.T) {
+	olderNamespace := policy("default", "z-older", v1beta1.MTLSModeDisable)
+	olderNamespace.CreationTimestamp = metav1.NewTime(created.Add(-time.Hour))
+
+	tests := []struct {
+		name     string
+		policies []v1beta1.PeerAuthentication
+		port     uint32
+		mode     v1beta1.MTLSMode
+		chain    []string
+		source   string
+	}{
+		{
+			name: "no policies",
+			mode: DefaultMode,
+		},
+		{
+			name:     "mesh",
+			policies: []v1beta1.PeerAuthentication{policy(DefaultRootNamespace, "default", v1beta1.MTLSModeStrict)},
+			mode:     v1beta1.MTLSModeStrict,
+			chain:    []string{"Mesh istio-system/default STRICT"},
+			source:   "istio-system/default",
+		},
+		{
+			name: "namespace over mesh",
+			policies: []v1beta1.PeerAuthentication{
+				policy("default", "default", v1beta1.MTLSModePermissive),
+				policy(DefaultRootNamespace, "default", v1beta1.MTLSModeStrict),
+			},
+			mode:   v1beta1.MTLSModePermissive,
+			chain:  []string{"Mesh istio-system/default STRICT", "Namespace default/default PERMISSIVE"},
+			source: "default/default",
+		},
+		{
+			name: "workload over namespace and mesh",
+			policies: []v1beta1.PeerAuthentication{
+				policy(DefaultRootNamespace, "default", v1beta1.MTLSModeStrict),
+				policy("default", "default", v1beta1.MTLSModePermissive),
+				workloadPolicy("default", "reviews", v1beta1.MTLSModeDisable, nil),
+			},
+			mode: v1beta1.MTLSModeDisable,
+			chain: []string{
+				"Mesh istio-system/default STRICT",
+				"Namespace default/default PERMISSIVE",
+				"Workload default/reviews DISABLE",
+			},
+			source: "default/reviews",
+		},
+		{
+			name: "port over workload",
+			policies: []v1beta1.PeerAuthentication{
+				policy(DefaultRootNamespace, "default", v1beta1.MTLSModeStrict),
+				workloadPolicy("default", "reviews", v1beta1.MTLSModeStrict, map[uint32]v1beta1.MTLSMode{9080: v1beta1.MTLSModeDisable}),
+			},
+			port: 9080,
+			mode: v1beta1.MTLSModeDisable,
+			chain: []string{
+				"Mesh istio-system/default STRICT",
+				"Workload default/reviews STRICT",
+				"Port default/reviews DISABLE",
+			},
+			source: "default/reviews",
+		},
+		{
+			name: "settings of other ports",
+			policies: []v1beta1.PeerAuthentication{
+				workloadPolicy("default", "reviews", v1beta1.MTLSModeStrict, map[uint32]v1beta1.MTLSMode{9080: v1beta1.MTLSModeDisable}),
+			},
+			port:   9090,
+			mode:   v1beta1.MTLSModeStrict,
+			chain:  []string{"Workload default/reviews STRICT"},
+			source: "default/reviews",
+		},
+		{
+			name: "port settings without a port",
+			policies: []v1beta1.PeerAuthentication{
+				workloadPolicy("default", "reviews", "", map[uint32]v1beta1.MTLSMode{0: v1beta1.MTLSModeDisable}),
+			},
+			mode:  DefaultMode,
+			chain: []string{"Workload default/reviews UNSET"},
+		},
+		{
+			name: "unset inherits from the level above",
+			policies: []v1beta1.PeerAuthentication{
+				policy(DefaultRootNamespace, "default", v1beta1.MTLSModeStrict),
+				policy("default", "default", v1beta1.MTLSModeUnset),
+				workloadPolicy("default", "reviews", "", map[uint32]v1beta1.MTLSMode{9080: v1beta1.MTLSModeUnset}),
+			},
+			port: 9080,
+			mode: v1beta1.MTLSModeStrict,
+			chain: []string{
+				"Mesh istio-system/default STRICT",
+				"Namespace default/default UNSET",
+				"Workload default/reviews UNSET",
+				"Port default/reviews UNSET",
+			},
+			source: "istio-system/default",
+		},
+		{
+			name: "unset port inherits from the workload",
+			policies: []v1beta1.PeerAuthentication{
+				policy("default", "default", v1beta1.MTLSModeStrict),
+				workloadPolicy("default", "reviews", v1beta1.MTLSModeDisable, map[uint32]v1beta1.MTLSMode{9080: ""}),
+			},
+			port:   9080,
+			mode:   v1beta1.MTLSModeDisable,
+			chain:  []string{"Namespace default/default STRICT", "Workload default/reviews DISABLE", "Port default/reviews UNSET"},
+			source: "default/reviews",
+		},
+		{
+			name: "unset everywhere",
+			policies: []v1beta1.PeerAuthentication{
+				policy(DefaultRootNamespace, "default", v1beta1.MTLSModeUnset),
+				policy("default", "default", ""),
+			},
+			mode:  DefaultMode,
+			chain: []string{"Mesh istio-system/default UNSET", "Namespace default/default UNSET"},
+		},
+		{
+			name: "oldest policy of a level",
+			policies: []v1beta1.PeerAuthentication{
+				policy("default", "a-newer", v1beta1.MTLSModeStrict),
+				olderNamespace,
+			},
+			mode:   v1beta1.MTLSModeDisable,
+			chain:  []string{"Namespace default/z-older DISABLE"},
+			source: "default/z-older",
+		},
+		{
+			name: "policies of other namespaces and workloads are ignored",
+			policies: []v1beta1.PeerAuthentication{
+				policy("other", "default", v1beta1.MTLSModeStrict),
+				workloadPolicy("other", "reviews", v1beta1.MTLSModeStrict, nil),
+				workloadPolicy(DefaultRootNamespace, "reviews", v1beta1.MTLSModeStrict, nil),
+				func() v1beta1.PeerAuthentication {
+					p := workloadPolicy("default", "ratings", v1beta1.MTLSModeStrict, nil)
+					p.Spec.Selector.MatchLabels = map[string]string{"app": "ratings"}
+					return p
+				}(),
+			},
+			mode: DefaultMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Resolve(tt.policies, Workload{
+				Namespace: "default",
+				Labels:    map[string]string{"app": "reviews", "version": "v1"},
+				Port:      tt.port,
+			})
+			if result.Mode != tt.mode {
+				t.Errorf("expected %s, got %s", tt.mode, result.Mode)
+			}
+
+			chain := []string{}
+			for _, step := range result.Chain {
+				chain = append(chain, string(step.Level)+" "+step.Policy.Namespace+"/"+step.Policy.Name+" "+string(step.Mode))
+			}
+			if tt.chain == nil {
+				tt.chain = []string{}
+			}
+			if !reflect.DeepEqual(chain, tt.chain) {
+				t.Errorf("expected the chain %v, got %v", tt.chain, chain)
+			}
+
+			source := ""
+			if step := result.Source(); step != nil {
+				source = step.Policy.Namespace + "/" + step.Policy.Name
+			}
+			if source != tt.source {
+				t.Errorf("expected the mode to be set by %q, got %q", tt.source, source)
+			}
+		})
+	}
+}
+
+func TestResolverRootNamespace(t *testing.T) {
+	r := Resolver{
+		RootNamespace: "mesh-config",
+		Policies: []v1beta1.PeerAuthentication{
+			policy(DefaultRootNamespace, "default", v1beta1.MTLSModeDisable),
+			policy("mesh-config", "default", v1beta1.MTLSModeStrict),
+		},
+	}
+
+	if result := r.Resolve(Workload{Namespace: "default"}); result.Mode != v1beta1.MTLSModeStrict {
+		t.Errorf("expected %s, got %s", v1beta1.MTLSModeStrict, result.Mode)
+	}
+}
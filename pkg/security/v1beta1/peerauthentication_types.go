@@ -15,11 +15,16 @@
 package v1beta1
 
 import (
+	"encoding/json"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
 
 	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
 )
 
+// +kubebuilder:validation:Enum=UNSET;DISABLE;PERMISSIVE;STRICT
 type MTLSMode string
 
 const (
@@ -35,6 +40,7 @@ const (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;security-istio-io,shortName=pa
 // PeerAuthentication
 type PeerAuthentication struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -126,7 +132,64 @@ type PeerAuthenticationSpec struct {
 	// Mutual TLS settings for workload. If not defined, inherit from parent.
 	Mtls *PeerAuthenticationMTLS `json:"mtls,omitempty"`
 	// Port specific mutual TLS settings.
-	PortLevelMtls map[uint32]*PeerAuthenticationMTLS `json:"portLevelMtls,omitempty"`
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	PortLevelMtls PortLevelMTLS `json:"portLevelMtls,omitzero"`
+}
+
+// PortLevelMTLS holds mutual TLS settings by port number. It is encoded as a
+// JSON object whose keys are the port numbers.
+type PortLevelMTLS struct {
+	Ports map[uint32]*PeerAuthenticationMTLS `json:"-"`
+}
+
+// IsZero reports whether there are no port level settings.
+func (p PortLevelMTLS) IsZero() bool {
+	return len(p.Ports) == 0
+}
+
+// MarshalJSON encodes the settings as an object keyed by port number.
+func (p PortLevelMTLS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Ports)
+}
+
+// UnmarshalJSON decodes the settings from an object keyed by port number.
+func (p *PortLevelMTLS) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &p.Ports)
+}
+
+// OpenAPIDefinition is used by the kube-openapi generator, which does not
+// support maps with integer keys, when constructing the OpenAPI spec of this
+// type.
+func (PortLevelMTLS) OpenAPIDefinition() common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Port specific mutual TLS settings, keyed by port number.",
+				Type:        []string{"object"},
+				AdditionalProperties: &spec.SchemaOrBool{
+					Allows: true,
+					Schema: &spec.Schema{
+						SchemaProps: spec.SchemaProps{
+							Description: "Mutual TLS settings.",
+							Type:        []string{"object"},
+							Properties: map[string]spec.Schema{
+								"mode": {
+									SchemaProps: spec.SchemaProps{
+										Description: "Defines the mTLS mode used for peer authentication.",
+										Type:        []string{"string"},
+										Enum:        []interface{}{"UNSET", "DISABLE", "PERMISSIVE", "STRICT"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 // Mutual TLS settings.
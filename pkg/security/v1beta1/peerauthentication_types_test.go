@@ -0,0 +1,60 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPortLevelMTLSJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{name: "ports", json: `{"portLevelMtls":{"8080":{"mode":"DISABLE"}}}`, want: `{"portLevelMtls":{"8080":{"mode":"DISABLE"}}}`},
+		{name: "no ports", json: `{}`, want: `{}`},
+		{name: "empty", json: `{"portLevelMtls":{}}`, want: `{}`},
+		{name: "null", json: `{"portLevelMtls":null}`, want: `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec PeerAuthenticationSpec
+			if err := json.Unmarshal([]byte(tt.json), &spec); err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := json.Marshal(spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, encoded)
+			}
+		})
+	}
+
+	var spec PeerAuthenticationSpec
+	if err := json.Unmarshal([]byte(`{"portLevelMtls":{"8080":{"mode":"DISABLE"}}}`), &spec); err != nil {
+		t.Fatal(err)
+	}
+	if mtls := spec.PortLevelMtls.Ports[8080]; mtls == nil || mtls.Mode != MTLSModeDisable {
+		t.Errorf("expected port 8080 to disable mTLS, got %v", mtls)
+	}
+	if err := json.Unmarshal([]byte(`{"portLevelMtls":{"http":{}}}`), &spec); err == nil {
+		t.Error("expected an error for a port name")
+	}
+}
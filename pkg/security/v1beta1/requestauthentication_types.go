@@ -122,6 +122,7 @@ import (
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:categories=istio-io;security-istio-io,shortName=ra
 // RequestAuthentication
 type RequestAuthentication struct {
 	metav1.TypeMeta   `json:",inline"`
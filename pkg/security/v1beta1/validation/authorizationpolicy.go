@@ -0,0 +1,266 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validation implements the semantic checks Istio applies to
+// security resources before accepting them.
+package validation
+
+import (
+	"strconv"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	commonvalidation "github.com/banzaicloud/istio-client-go/pkg/common/v1alpha1/validation"
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// supportedConditionKeys lists the attributes which may be used as the key of
+// a Condition. Keys ending with "[<name>]" take a name inside the brackets,
+// e.g. request.headers[User-Agent].
+var supportedConditionKeys = []string{
+	"request.headers[<name>]",
+	"source.ip",
+	"source.namespace",
+	"source.principal",
+	"request.auth.principal",
+	"request.auth.audiences",
+	"request.auth.presenter",
+	"request.auth.claims[<name>]",
+	"destination.ip",
+	"destination.port",
+	"connection.sni",
+}
+
+// ValidateAuthorizationPolicy validates the spec of an AuthorizationPolicy.
+func ValidateAuthorizationPolicy(policy *v1beta1.AuthorizationPolicy) field.ErrorList {
+	return ValidateAuthorizationPolicySpec(&policy.Spec, field.NewPath("spec"))
+}
+
+// ValidateAuthorizationPolicySpec validates an AuthorizationPolicySpec.
+func ValidateAuthorizationPolicySpec(spec *v1beta1.AuthorizationPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateWorkloadSelector(spec.Selector, fldPath.Child("selector"))...)
+
+	switch spec.Action {
+	case "", v1beta1.AuthorizationPolicyActionAllow, v1beta1.AuthorizationPolicyActionDeny:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), spec.Action, []string{
+			string(v1beta1.AuthorizationPolicyActionAllow),
+			string(v1beta1.AuthorizationPolicyActionDeny),
+		}))
+	}
+
+	for i, rule := range spec.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+		if rule == nil {
+			allErrs = append(allErrs, field.Required(rulePath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateRule(rule, rulePath)...)
+	}
+
+	return allErrs
+}
+
+func validateWorkloadSelector(selector *selector.WorkloadSelector, fldPath *field.Path) field.ErrorList {
+	if selector == nil {
+		return field.ErrorList{}
+	}
+
+	return metav1validation.ValidateLabels(selector.MatchLabels, fldPath.Child("matchLabels"))
+}
+
+func validateRule(rule *v1beta1.Rule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, from := range rule.From {
+		sourcePath := fldPath.Child("from").Index(i).Child("source")
+		if from == nil || from.Source == nil {
+			allErrs = append(allErrs, field.Required(sourcePath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateSource(from.Source, sourcePath)...)
+	}
+	for i, to := range rule.To {
+		operationPath := fldPath.Child("to").Index(i).Child("operation")
+		if to == nil || to.Operation == nil {
+			allErrs = append(allErrs, field.Required(operationPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateOperation(to.Operation, operationPath)...)
+	}
+	for i, condition := range rule.When {
+		conditionPath := fldPath.Child("when").Index(i)
+		if condition == nil {
+			allErrs = append(allErrs, field.Required(conditionPath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateCondition(condition, conditionPath)...)
+	}
+
+	return allErrs
+}
+
+func validateSource(source *v1beta1.Source, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(source.Principals) == 0 && len(source.NotPrincipals) == 0 &&
+		len(source.RequestPrincipals) == 0 && len(source.NotRequestPrincipals) == 0 &&
+		len(source.Namespaces) == 0 && len(source.NotNamespaces) == 0 &&
+		len(source.IPBlocks) == 0 && len(source.NotIPBlocks) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one field must be set"))
+	}
+
+	allErrs = append(allErrs, validateValues(source.Principals, fldPath.Child("principals"))...)
+	allErrs = append(allErrs, validateValues(source.NotPrincipals, fldPath.Child("notPrincipals"))...)
+	allErrs = append(allErrs, validateValues(source.RequestPrincipals, fldPath.Child("requestPrincipals"))...)
+	allErrs = append(allErrs, validateValues(source.NotRequestPrincipals, fldPath.Child("notRequestPrincipals"))...)
+	allErrs = append(allErrs, validateValues(source.Namespaces, fldPath.Child("namespaces"))...)
+	allErrs = append(allErrs, validateValues(source.NotNamespaces, fldPath.Child("notNamespaces"))...)
+	allErrs = append(allErrs, validateIPBlocks(source.IPBlocks, fldPath.Child("ipBlocks"))...)
+	allErrs = append(allErrs, validateIPBlocks(source.NotIPBlocks, fldPath.Child("notIpBlocks"))...)
+
+	return allErrs
+}
+
+func validateOperation(operation *v1beta1.Operation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(operation.Hosts) == 0 && len(operation.NotHosts) == 0 &&
+		len(operation.Ports) == 0 && len(operation.NotPorts) == 0 &&
+		len(operation.Methods) == 0 && len(operation.NotMethods) == 0 &&
+		len(operation.Paths) == 0 && len(operation.NotPaths) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one field must be set"))
+	}
+
+	allErrs = append(allErrs, validateValues(operation.Hosts, fldPath.Child("hosts"))...)
+	allErrs = append(allErrs, validateValues(operation.NotHosts, fldPath.Child("notHosts"))...)
+	allErrs = append(allErrs, validatePorts(operation.Ports, fldPath.Child("ports"))...)
+	allErrs = append(allErrs, validatePorts(operation.NotPorts, fldPath.Child("notPorts"))...)
+	allErrs = append(allErrs, validateValues(operation.Methods, fldPath.Child("methods"))...)
+	allErrs = append(allErrs, validateValues(operation.NotMethods, fldPath.Child("notMethods"))...)
+	allErrs = append(allErrs, validatePaths(operation.Paths, fldPath.Child("paths"))...)
+	allErrs = append(allErrs, validatePaths(operation.NotPaths, fldPath.Child("notPaths"))...)
+
+	return allErrs
+}
+
+func validateCondition(condition *v1beta1.Condition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !isSupportedConditionKey(condition.Key) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("key"), condition.Key, supportedConditionKeys))
+	}
+	if len(condition.Values) == 0 && len(condition.NotValues) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of values or notValues must be set"))
+	}
+
+	switch condition.Key {
+	case "source.ip", "destination.ip":
+		allErrs = append(allErrs, validateIPBlocks(condition.Values, fldPath.Child("values"))...)
+		allErrs = append(allErrs, validateIPBlocks(condition.NotValues, fldPath.Child("notValues"))...)
+	case "destination.port":
+		allErrs = append(allErrs, validatePorts(condition.Values, fldPath.Child("values"))...)
+		allErrs = append(allErrs, validatePorts(condition.NotValues, fldPath.Child("notValues"))...)
+	default:
+		allErrs = append(allErrs, validateValues(condition.Values, fldPath.Child("values"))...)
+		allErrs = append(allErrs, validateValues(condition.NotValues, fldPath.Child("notValues"))...)
+	}
+
+	return allErrs
+}
+
+func isSupportedConditionKey(key string) bool {
+	for _, supported := range supportedConditionKeys {
+		i := strings.Index(supported, "[")
+		if i < 0 {
+			if key == supported {
+				return true
+			}
+			continue
+		}
+		prefix := supported[:i+1]
+		if strings.HasPrefix(key, prefix) && strings.HasSuffix(key, "]") && len(key) > len(prefix)+1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateValues(values []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, value := range values {
+		if value == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), value, "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateIPBlocks(blocks []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, block := range blocks {
+		allErrs = append(allErrs, commonvalidation.ValidateIPOrCIDR(block, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validatePorts(ports []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, port := range ports {
+		number, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), port, "must be a port number"))
+			continue
+		}
+		allErrs = append(allErrs, commonvalidation.ValidatePort(number, fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validatePaths(paths []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, path := range paths {
+		if path == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, "must not be empty"))
+			continue
+		}
+		if path == "*" {
+			continue
+		}
+		trimmed := path
+		if strings.HasPrefix(trimmed, "*") {
+			trimmed = trimmed[1:]
+		} else if strings.HasSuffix(trimmed, "*") {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if strings.Contains(trimmed, "*") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, "wildcard is only supported as a prefix or a suffix"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,151 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	selector "github.com/banzaicloud/istio-client-go/pkg/type/v1beta1"
+)
+
+// invalidFields returns the paths of the invalid fields in the order they
+// were reported.
+func invalidFields(errs field.ErrorList) []string {
+	fields := []string{}
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+
+	return fields
+}
+
+func from(source v1beta1.Source) *v1beta1.Rule {
+	return &v1beta1.Rule{From: []*v1beta1.RuleFrom{{Source: &source}}}
+}
+
+func to(operation v1beta1.Operation) *v1beta1.Rule {
+	return &v1beta1.Rule{To: []*v1beta1.RuleTo{{Operation: &operation}}}
+}
+
+func when(condition v1beta1.Condition) *v1beta1.Rule {
+	return &v1beta1.Rule{When: []*v1beta1.Condition{&condition}}
+}
+
+func TestValidateAuthorizationPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   v1beta1.AuthorizationPolicySpec
+		fields []string
+	}{
+		{
+			name: "allow nothing",
+		},
+		{
+			name: "deny with a selector",
+			spec: v1beta1.AuthorizationPolicySpec{
+				Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+				Action:   v1beta1.AuthorizationPolicyActionDeny,
+				Rules:    []*v1beta1.Rule{from(v1beta1.Source{Namespaces: []string{"prod"}})},
+			},
+		},
+		{
+			name: "invalid selector",
+			spec: v1beta1.AuthorizationPolicySpec{
+				Selector: &selector.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews v1"}},
+			},
+			fields: []string{"spec.selector.matchLabels"},
+		},
+		{
+			name:   "unknown action",
+			spec:   v1beta1.AuthorizationPolicySpec{Action: "AUDIT"},
+			fields: []string{"spec.action"},
+		},
+		{
+			name:   "missing rule",
+			spec:   v1beta1.AuthorizationPolicySpec{Rules: []*v1beta1.Rule{nil, {}}},
+			fields: []string{"spec.rules[0]"},
+		},
+		{
+			name: "missing source and operation",
+			spec: v1beta1.AuthorizationPolicySpec{Rules: []*v1beta1.Rule{{
+				From: []*v1beta1.RuleFrom{{}},
+				To:   []*v1beta1.RuleTo{{}},
+			}}},
+			fields: []string{"spec.rules[0].from[0].source", "spec.rules[0].to[0].operation"},
+		},
+		{
+			name: "empty source and operation",
+			spec: v1beta1.AuthorizationPolicySpec{Rules: []*v1beta1.Rule{
+				from(v1beta1.Source{}),
+				to(v1beta1.Operation{}),
+			}},
+			fields: []string{"spec.rules[0].from[0].source", "spec.rules[1].to[0].operation"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := invalidFields(ValidateAuthorizationPolicy(&v1beta1.AuthorizationPolicy{Spec: tt.spec}))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   *v1beta1.Rule
+		fields []string
+	}{
+		{name: "principals", rule: from(v1beta1.Source{Principals: []string{"cluster.local/ns/default/sa/sleep", "*"}})},
+		{name: "empty principal", rule: from(v1beta1.Source{NotPrincipals: []string{""}}), fields: []string{"spec.rules[0].from[0].source.notPrincipals[0]"}},
+		{name: "ip blocks", rule: from(v1beta1.Source{IPBlocks: []string{"10.0.0.1", "10.0.0.0/16"}})},
+		{name: "invalid ip blocks", rule: from(v1beta1.Source{NotIPBlocks: []string{"10.0.0.0/33", "ten"}}), fields: []string{"spec.rules[0].from[0].source.notIpBlocks[0]", "spec.rules[0].from[0].source.notIpBlocks[1]"}},
+		{name: "ports", rule: to(v1beta1.Operation{Ports: []string{"8080"}})},
+		{name: "invalid ports", rule: to(v1beta1.Operation{Ports: []string{"http", "0"}}), fields: []string{"spec.rules[0].to[0].operation.ports[0]", "spec.rules[0].to[0].operation.ports[1]"}},
+		{name: "path wildcards", rule: to(v1beta1.Operation{Paths: []string{"*", "/api/*", "*/info"}})},
+		{name: "path wildcard in the middle", rule: to(v1beta1.Operation{NotPaths: []string{"/api/*/info", ""}}), fields: []string{"spec.rules[0].to[0].operation.notPaths[0]", "spec.rules[0].to[0].operation.notPaths[1]"}},
+		{name: "header condition", rule: when(v1beta1.Condition{Key: "request.headers[User-Agent]", Values: []string{"curl/*"}})},
+		{name: "claim condition", rule: when(v1beta1.Condition{Key: "request.auth.claims[groups]", NotValues: []string{"guest"}})},
+		{name: "condition without a name", rule: when(v1beta1.Condition{Key: "request.headers[]", Values: []string{"curl"}}), fields: []string{"spec.rules[0].when[0].key"}},
+		{name: "unknown condition", rule: when(v1beta1.Condition{Key: "request.method", Values: []string{"GET"}}), fields: []string{"spec.rules[0].when[0].key"}},
+		{name: "condition without values", rule: when(v1beta1.Condition{Key: "source.namespace"}), fields: []string{"spec.rules[0].when[0]"}},
+		{name: "ip condition", rule: when(v1beta1.Condition{Key: "destination.ip", Values: []string{"10.0.0.0/8"}, NotValues: []string{"ten"}}), fields: []string{"spec.rules[0].when[0].notValues[0]"}},
+		{name: "port condition", rule: when(v1beta1.Condition{Key: "destination.port", Values: []string{"65536"}}), fields: []string{"spec.rules[0].when[0].values[0]"}},
+		{name: "missing condition", rule: &v1beta1.Rule{When: []*v1beta1.Condition{nil}}, fields: []string{"spec.rules[0].when[0]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1beta1.AuthorizationPolicy{Spec: v1beta1.AuthorizationPolicySpec{Rules: []*v1beta1.Rule{tt.rule}}}
+			fields := invalidFields(ValidateAuthorizationPolicy(policy))
+			if tt.fields == nil {
+				tt.fields = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("expected errors on %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
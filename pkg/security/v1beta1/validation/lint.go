@@ -0,0 +1,142 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1/authz"
+)
+
+// Warning describes a valid configuration which most likely does not behave
+// the way it was intended to.
+type Warning struct {
+	Field   string
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// LintAuthorizationPolicy returns warnings for rules of the policy which can
+// never match, or which match every request where that is unlikely intended.
+func LintAuthorizationPolicy(policy *v1beta1.AuthorizationPolicy) []Warning {
+	return LintAuthorizationPolicySpec(&policy.Spec, field.NewPath("spec"))
+}
+
+// LintAuthorizationPolicySpec is like LintAuthorizationPolicy for a bare spec.
+func LintAuthorizationPolicySpec(spec *v1beta1.AuthorizationPolicySpec, fldPath *field.Path) []Warning {
+	var warnings []Warning
+
+	deny := spec.Action == v1beta1.AuthorizationPolicyActionDeny
+	if len(spec.Rules) == 0 {
+		if deny {
+			warnings = append(warnings, Warning{fldPath.Child("rules").String(), "DENY policy without rules never matches and has no effect"})
+		} else {
+			warnings = append(warnings, Warning{fldPath.Child("rules").String(), "ALLOW policy without rules denies all requests"})
+		}
+	}
+
+	for i, rule := range spec.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+		if rule == nil {
+			continue
+		}
+		if deny && len(rule.From) == 0 && len(rule.To) == 0 && len(rule.When) == 0 {
+			warnings = append(warnings, Warning{rulePath.String(), "empty rule on a DENY policy matches and denies all requests"})
+		}
+		warnings = append(warnings, lintRule(rule, rulePath)...)
+	}
+
+	return warnings
+}
+
+func lintRule(rule *v1beta1.Rule, fldPath *field.Path) []Warning {
+	var warnings []Warning
+
+	for i, from := range rule.From {
+		if from == nil || from.Source == nil {
+			continue
+		}
+		source := from.Source
+		sourcePath := fldPath.Child("from").Index(i).Child("source")
+		warnings = append(warnings, lintNegation(source.Principals, source.NotPrincipals, sourcePath, "principals", "notPrincipals")...)
+		warnings = append(warnings, lintNegation(source.RequestPrincipals, source.NotRequestPrincipals, sourcePath, "requestPrincipals", "notRequestPrincipals")...)
+		warnings = append(warnings, lintNegation(source.Namespaces, source.NotNamespaces, sourcePath, "namespaces", "notNamespaces")...)
+		warnings = append(warnings, lintNegation(source.IPBlocks, source.NotIPBlocks, sourcePath, "ipBlocks", "notIpBlocks")...)
+	}
+	for i, to := range rule.To {
+		if to == nil || to.Operation == nil {
+			continue
+		}
+		operation := to.Operation
+		operationPath := fldPath.Child("to").Index(i).Child("operation")
+		warnings = append(warnings, lintNegation(operation.Hosts, operation.NotHosts, operationPath, "hosts", "notHosts")...)
+		warnings = append(warnings, lintNegation(operation.Ports, operation.NotPorts, operationPath, "ports", "notPorts")...)
+		warnings = append(warnings, lintNegation(operation.Methods, operation.NotMethods, operationPath, "methods", "notMethods")...)
+		warnings = append(warnings, lintNegation(operation.Paths, operation.NotPaths, operationPath, "paths", "notPaths")...)
+	}
+	for i, condition := range rule.When {
+		if condition == nil {
+			continue
+		}
+		warnings = append(warnings, lintNegation(condition.Values, condition.NotValues, fldPath.Child("when").Index(i), "values", "notValues")...)
+	}
+
+	return warnings
+}
+
+// lintNegation warns when every value of the positive list is excluded by the
+// negative list as well, so the match can never succeed.
+func lintNegation(values, notValues []string, fldPath *field.Path, name, notName string) []Warning {
+	if len(notValues) == 0 {
+		return nil
+	}
+
+	if len(values) == 0 {
+		for _, notValue := range notValues {
+			if notValue == "*" {
+				return []Warning{{fldPath.Child(notName).String(), fmt.Sprintf("%q excludes every value, the rule never matches", notValue)}}
+			}
+		}
+		return nil
+	}
+
+	for _, value := range values {
+		if !isExcluded(value, notValues) {
+			return nil
+		}
+	}
+
+	return []Warning{{fldPath.Child(name).String(), fmt.Sprintf("every value is excluded by %s, the rule never matches", notName)}}
+}
+
+func isExcluded(value string, notValues []string) bool {
+	for _, notValue := range notValues {
+		if notValue == "*" || notValue == value {
+			return true
+		}
+		if !strings.Contains(value, "*") && authz.MatchString(notValue, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+// Copyright © 2020 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/banzaicloud/istio-client-go/pkg/security/v1beta1"
+)
+
+func TestLintAuthorizationPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   v1beta1.AuthorizationPolicyAction
+		rules    []*v1beta1.Rule
+		warnings []string
+	}{
+		{
+			name:     "allow without rules",
+			warnings: []string{"spec.rules"},
+		},
+		{
+			name:     "deny without rules",
+			action:   v1beta1.AuthorizationPolicyActionDeny,
+			warnings: []string{"spec.rules"},
+		},
+		{
+			name:  "allow with an empty rule",
+			rules: []*v1beta1.Rule{{}},
+		},
+		{
+			name:     "deny with an empty rule",
+			action:   v1beta1.AuthorizationPolicyActionDeny,
+			rules:    []*v1beta1.Rule{{}},
+			warnings: []string{"spec.rules[0]"},
+		},
+		{
+			name:   "deny with a rule",
+			action: v1beta1.AuthorizationPolicyActionDeny,
+			rules:  []*v1beta1.Rule{to(v1beta1.Operation{Methods: []string{"DELETE"}})},
+		},
+		{
+			name:  "negation of other values",
+			rules: []*v1beta1.Rule{to(v1beta1.Operation{Paths: []string{"/api/*"}, NotPaths: []string{"/api/internal"}})},
+		},
+		{
+			name:     "negation of every value",
+			rules:    []*v1beta1.Rule{to(v1beta1.Operation{Methods: []string{"GET", "HEAD"}, NotMethods: []string{"HEAD", "GET"}})},
+			warnings: []string{"spec.rules[0].to[0].operation.methods"},
+		},
+		{
+			name:     "negation by a wildcard",
+			rules:    []*v1beta1.Rule{from(v1beta1.Source{Principals: []string{"cluster.local/ns/default/sa/sleep"}, NotPrincipals: []string{"*/sa/sleep"}})},
+			warnings: []string{"spec.rules[0].from[0].source.principals"},
+		},
+		{
+			name:  "wildcard value not covered by a negation",
+			rules: []*v1beta1.Rule{to(v1beta1.Operation{Hosts: []string{"*.example.com"}, NotHosts: []string{"api.example.com"}})},
+		},
+		{
+			name:     "negation of any value",
+			rules:    []*v1beta1.Rule{from(v1beta1.Source{NotNamespaces: []string{"*"}})},
+			warnings: []string{"spec.rules[0].from[0].source.notNamespaces"},
+		},
+		{
+			name:     "condition",
+			rules:    []*v1beta1.Rule{when(v1beta1.Condition{Key: "source.namespace", Values: []string{"prod"}, NotValues: []string{"prod"}})},
+			warnings: []string{"spec.rules[0].when[0].values"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &v1beta1.AuthorizationPolicy{Spec: v1beta1.AuthorizationPolicySpec{Action: tt.action, Rules: tt.rules}}
+			fields := []string{}
+			for _, warning := range LintAuthorizationPolicy(policy) {
+				fields = append(fields, warning.Field)
+			}
+			if tt.warnings == nil {
+				tt.warnings = []string{}
+			}
+			if !reflect.DeepEqual(fields, tt.warnings) {
+				t.Errorf("expected warnings on %v, got %v", tt.warnings, fields)
+			}
+		})
+	}
+}
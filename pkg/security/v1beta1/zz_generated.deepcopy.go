@@ -1,4 +1,4 @@
-// +build !ignore_autogenerated
+//go:build !ignore_autogenerated
 
 // Copyright © 2019 Banzai Cloud
 //
@@ -53,7 +53,7 @@ func (in *AuthorizationPolicy) DeepCopyObject() runtime.Object {
 func (in *AuthorizationPolicyList) DeepCopyInto(out *AuthorizationPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]AuthorizationPolicy, len(*in))
@@ -273,7 +273,7 @@ func (in *PeerAuthentication) DeepCopyObject() runtime.Object {
 func (in *PeerAuthenticationList) DeepCopyInto(out *PeerAuthenticationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]PeerAuthentication, len(*in))
@@ -329,15 +329,32 @@ func (in *PeerAuthenticationSpec) DeepCopyInto(out *PeerAuthenticationSpec) {
 		*out = new(PeerAuthenticationMTLS)
 		**out = **in
 	}
-	if in.PortLevelMtls != nil {
-		in, out := &in.PortLevelMtls, &out.PortLevelMtls
+	in.PortLevelMtls.DeepCopyInto(&out.PortLevelMtls)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerAuthenticationSpec.
+func (in *PeerAuthenticationSpec) DeepCopy() *PeerAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortLevelMTLS) DeepCopyInto(out *PortLevelMTLS) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
 		*out = make(map[uint32]*PeerAuthenticationMTLS, len(*in))
 		for key, val := range *in {
 			var outVal *PeerAuthenticationMTLS
 			if val == nil {
 				(*out)[key] = nil
 			} else {
-				in, out := &val, &outVal
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
 				*out = new(PeerAuthenticationMTLS)
 				**out = **in
 			}
@@ -346,12 +363,12 @@ func (in *PeerAuthenticationSpec) DeepCopyInto(out *PeerAuthenticationSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerAuthenticationSpec.
-func (in *PeerAuthenticationSpec) DeepCopy() *PeerAuthenticationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortLevelMTLS.
+func (in *PortLevelMTLS) DeepCopy() *PortLevelMTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(PeerAuthenticationSpec)
+	out := new(PortLevelMTLS)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -386,7 +403,7 @@ func (in *RequestAuthentication) DeepCopyObject() runtime.Object {
 func (in *RequestAuthenticationList) DeepCopyInto(out *RequestAuthenticationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]RequestAuthentication, len(*in))
@@ -21,7 +21,7 @@ FILES=$(find . -name "*.go")
 
 for FILE in $FILES; do
     # Skip check for autogenerated files
-    if ! head -n 1 $FILE | grep '// +build' &>/dev/null; then
+    if ! head -n 1 $FILE | grep -E '// ?(\+build|go:build)' &>/dev/null; then
         # Replace the actual year with DATE so we can ignore the year when
         # checking for the license header.
         HEADER=$(head -n 13 $FILE | sed -E -e 's/Copyright © [0-9]+/Copyright © DATE/')